@@ -1,6 +1,12 @@
 package address
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
 
 const (
 
@@ -43,3 +49,72 @@ func ConfigureBech32Prefix() {
 	config.SetBech32PrefixForConsensusNode(Bech32PrefixConsAddr, Bech32PrefixConsPub)
 	config.Seal()
 }
+
+// Key types of the bech32 prefixes
+const (
+	KeyTypeAcc  = "acc"
+	KeyTypeVal  = "val"
+	KeyTypeCons = "cons"
+)
+
+// LegacyChainPrefixes are the chain prefixes of the addresses of the chains
+// this one was migrated from, such as faa1... and iaa1...
+var LegacyChainPrefixes = []string{"f", "i"}
+
+var keyTypePrefixes = map[string]string{
+	KeyTypeAcc:  PrefixAcc,
+	KeyTypeVal:  PrefixValidator,
+	KeyTypeCons: PrefixConsensus,
+}
+
+// Bech32Prefix returns the bech32 prefix of the chain prefix for an address,
+// or a public key, of the key type
+func Bech32Prefix(chainPrefix, keyType string, pub bool) (string, error) {
+	prefix, ok := keyTypePrefixes[keyType]
+	if !ok {
+		return "", fmt.Errorf("unknown key type %s, must be one of %s, %s or %s", keyType, KeyTypeAcc, KeyTypeVal, KeyTypeCons)
+	}
+	if pub {
+		return chainPrefix + prefix + PrefixPublic, nil
+	}
+	return chainPrefix + prefix + PrefixAddress, nil
+}
+
+// ParseBech32Prefix returns the chain prefix, among the given ones, and the
+// key type of a bech32 prefix, and whether it is the prefix of a public key
+func ParseBech32Prefix(bech32Prefix string, chainPrefixes ...string) (chainPrefix, keyType string, pub bool, err error) {
+	for _, chainPrefix := range chainPrefixes {
+		if !strings.HasPrefix(bech32Prefix, chainPrefix) {
+			continue
+		}
+		for keyType := range keyTypePrefixes {
+			for _, pub := range []bool{false, true} {
+				if prefix, _ := Bech32Prefix(chainPrefix, keyType, pub); prefix == bech32Prefix {
+					return chainPrefix, keyType, pub, nil
+				}
+			}
+		}
+	}
+	return "", "", false, fmt.Errorf("unknown bech32 prefix %s", bech32Prefix)
+}
+
+// ConvertBech32 re-encodes a bech32 address, or public key, with the prefix
+// of another chain prefix and key type, the key type being kept if empty
+func ConvertBech32(bech32Str, chainPrefix, keyType string, chainPrefixes ...string) (string, error) {
+	bech32Prefix, bz, err := bech32.DecodeAndConvert(bech32Str)
+	if err != nil {
+		return "", err
+	}
+	_, fromKeyType, pub, err := ParseBech32Prefix(bech32Prefix, chainPrefixes...)
+	if err != nil {
+		return "", err
+	}
+	if keyType == "" {
+		keyType = fromKeyType
+	}
+	prefix, err := Bech32Prefix(chainPrefix, keyType, pub)
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(prefix, bz)
+}
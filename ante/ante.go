@@ -1,11 +1,13 @@
 package app
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	guardiankeeper "github.com/furynet/furyhub/modules/guardian/keeper"
+	txpolicykeeper "github.com/furynet/furyhub/modules/txpolicy/keeper"
 
 	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
@@ -15,10 +17,12 @@ import (
 // channel keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
+	Codec                codec.Codec
 	BankKeeper           bankkeeper.Keeper
 	TokenKeeper          tokenkeeper.Keeper
 	OracleKeeper         oraclekeeper.Keeper
 	GuardianKeeper       guardiankeeper.Keeper
+	TxPolicyKeeper       txpolicykeeper.Keeper
 	BypassMinFeeMsgTypes []string
 }
 
@@ -42,10 +46,13 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(opts.AccountKeeper, opts.SignModeHandler),
-		NewValidateTokenDecorator(opts.TokenKeeper),
+		NewValidateBlocklistDecorator(opts.GuardianKeeper),
+		NewRateLimitDecorator(opts.TxPolicyKeeper, opts.GuardianKeeper),
+		NewValidateTokenDecorator(opts.TokenKeeper, opts.TxPolicyKeeper),
 		tokenkeeper.NewValidateTokenFeeDecorator(opts.TokenKeeper, opts.BankKeeper),
 		oraclekeeper.NewValidateOracleAuthDecorator(opts.OracleKeeper, opts.GuardianKeeper),
-		NewValidateServiceDecorator(),
+		NewValidateServiceDecorator(opts.TxPolicyKeeper),
+		NewValidateUpgradePlanDecorator(opts.Codec),
 		ante.NewIncrementSequenceDecorator(opts.AccountKeeper),
 	), nil
 }
@@ -1,62 +1,84 @@
 package app
 
 import (
+	"reflect"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 
 	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
 	servicetypes "github.com/irisnet/irismod/modules/service/types"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	migratetibc "github.com/furynet/furyhub/migrate/tibc"
+	guardiankeeper "github.com/furynet/furyhub/modules/guardian/keeper"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	txpolicykeeper "github.com/furynet/furyhub/modules/txpolicy/keeper"
+	txpolicytypes "github.com/furynet/furyhub/modules/txpolicy/types"
+)
+
+var (
+	coinType  = reflect.TypeOf(sdk.Coin{})
+	coinsType = reflect.TypeOf(sdk.Coins{})
 )
 
 // ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix
 type ValidateTokenDecorator struct {
-	tk tokenkeeper.Keeper
+	tk  tokenkeeper.Keeper
+	tpk txpolicykeeper.Keeper
 }
 
 // NewValidateTokenDecorator returns an instance of ValidateTokenDecorator
-func NewValidateTokenDecorator(tk tokenkeeper.Keeper) ValidateTokenDecorator {
+func NewValidateTokenDecorator(tk tokenkeeper.Keeper, tpk txpolicykeeper.Keeper) ValidateTokenDecorator {
 	return ValidateTokenDecorator{
-		tk: tk,
+		tk:  tk,
+		tpk: tpk,
 	}
 }
 
 // AnteHandle checks the transaction
 func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := vtd.tpk.GetParams(ctx)
 	for _, msg := range tx.GetMsgs() {
 		switch msg := msg.(type) {
 		case *ibctransfertypes.MsgTransfer:
-			if containSwapCoin(msg.Token) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't transfer coinswap liquidity tokens through the IBC module")
+			if params.IsIBCBlockedDenom(msg.Token.Denom) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't transfer %s through the IBC module", msg.Token.Denom)
 			}
 		case *tokentypes.MsgBurnToken:
 			if _, err := vtd.tk.GetToken(ctx, msg.Symbol); err != nil {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "burnt failed, only native tokens can be burnt")
 			}
-		case *govv1.MsgSubmitProposal:
-			if containSwapCoin(msg.InitialDeposit...) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
-			}
-		case *govv1.MsgDeposit:
-			if containSwapCoin(msg.Amount...) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
-			}
+		}
+
+		if typeURL := sdk.MsgTypeURL(msg); params.IsLptRestricted(typeURL) && containSwapCoin(msgCoins(msg)...) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't use coinswap liquidity tokens in %s", typeURL)
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
 // ValidateServiceDecorator is responsible for checking the permission to execute MsgCallService
-type ValidateServiceDecorator struct{}
+type ValidateServiceDecorator struct {
+	tpk txpolicykeeper.Keeper
+}
 
 // NewValidateServiceDecorator returns an instance of ServiceAuthDecorator
-func NewValidateServiceDecorator() ValidateServiceDecorator {
-	return ValidateServiceDecorator{}
+func NewValidateServiceDecorator(tpk txpolicykeeper.Keeper) ValidateServiceDecorator {
+	return ValidateServiceDecorator{
+		tpk: tpk,
+	}
 }
 
 // AnteHandle checks the transaction
@@ -64,7 +86,7 @@ func (vsd ValidateServiceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	for _, msg := range tx.GetMsgs() {
 		switch msg := msg.(type) {
 		case *servicetypes.MsgCallService:
-			if msg.Repeated {
+			if msg.Repeated && !vsd.tpk.GetParams(ctx).AllowRepeatedService {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "currently does not support to create repeatable service invocation")
 			}
 		}
@@ -80,3 +102,245 @@ func containSwapCoin(coins ...sdk.Coin) bool {
 	}
 	return false
 }
+
+// msgCoins collects every sdk.Coin carried by the exported fields of msg,
+// so that any message type can be listed in the LPT restriction param.
+func msgCoins(msg sdk.Msg) (coins []sdk.Coin) {
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			if v.Type() == coinType {
+				coins = append(coins, v.Interface().(sdk.Coin))
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					walk(v.Field(i))
+				}
+			}
+		case reflect.Slice, reflect.Array:
+			if v.Type() == coinsType {
+				coins = append(coins, v.Interface().(sdk.Coins)...)
+				return
+			}
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return
+			}
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		}
+	}
+	walk(reflect.ValueOf(msg))
+	return coins
+}
+
+// ValidateBlocklistDecorator rejects transactions signed by an address on the guardian blocklist
+type ValidateBlocklistDecorator struct {
+	gk guardiankeeper.Keeper
+}
+
+// NewValidateBlocklistDecorator returns an instance of ValidateBlocklistDecorator
+func NewValidateBlocklistDecorator(gk guardiankeeper.Keeper) ValidateBlocklistDecorator {
+	return ValidateBlocklistDecorator{
+		gk: gk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vbd ValidateBlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if granter := feeTx.FeeGranter(); granter != nil && vbd.gk.IsBlocked(ctx, granter) {
+			return ctx, sdkerrors.Wrapf(guardiantypes.ErrAddressBlocked, "fee granter %s", granter)
+		}
+	}
+	if err := vbd.validateSigners(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// validateSigners also looks into the messages executed through authz, so that
+// a blocked granter can't move funds through a grantee
+func (vbd ValidateBlocklistDecorator) validateSigners(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if vbd.gk.IsBlocked(ctx, signer) {
+				return sdkerrors.Wrapf(guardiantypes.ErrAddressBlocked, "signer %s", signer)
+			}
+		}
+		if err := vbd.validateRecipients(ctx, msg); err != nil {
+			return err
+		}
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := vbd.validateSigners(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateRecipients rejects bank transfers to blocked addresses, the bank
+// module handles them with the unrestricted base keeper
+func (vbd ValidateBlocklistDecorator) validateRecipients(ctx sdk.Context, msg sdk.Msg) error {
+	var recipients []string
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		recipients = append(recipients, msg.ToAddress)
+	case *banktypes.MsgMultiSend:
+		for _, out := range msg.Outputs {
+			recipients = append(recipients, out.Address)
+		}
+	}
+	for _, recipient := range recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+		if vbd.gk.IsBlocked(ctx, addr) {
+			return sdkerrors.Wrapf(guardiantypes.ErrAddressBlocked, "%s is not allowed to receive funds", recipient)
+		}
+	}
+	return nil
+}
+
+// RateLimitDecorator limits the number of txs an account can send per window
+// of blocks. The windows are tumbling: they start at the multiples of the
+// window length (see Params.WindowStart) and every count is reset at the start
+// of the next window, rather than rolling over the last blocks.
+type RateLimitDecorator struct {
+	tpk txpolicykeeper.Keeper
+	gk  guardiankeeper.Keeper
+}
+
+// NewRateLimitDecorator returns an instance of RateLimitDecorator
+func NewRateLimitDecorator(tpk txpolicykeeper.Keeper, gk guardiankeeper.Keeper) RateLimitDecorator {
+	return RateLimitDecorator{
+		tpk: tpk,
+		gk:  gk,
+	}
+}
+
+// AnteHandle checks the transaction. The txs are counted in both CheckTx and
+// DeliverTx, so that CheckTx sees the txs committed in the current window, but
+// the limit is only enforced in DeliverTx if the params require it. Each
+// signer of the tx is counted, and only once all of them are within the limit,
+// so that a rejected tx takes up no slot.
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := rld.tpk.GetParams(ctx)
+	if simulate || !params.RateLimitEnabled() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	exempt := make(map[string]bool, len(params.RateLimitExemptModules))
+	for _, name := range params.RateLimitExemptModules {
+		exempt[authtypes.NewModuleAddress(name).String()] = true
+	}
+
+	// the counters are bookkeeping of the chain, they are not charged to the tx
+	counterCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	windowStart := params.WindowStart(ctx.BlockHeight())
+	enforce := ctx.IsCheckTx() || params.EnforceInDeliverTx
+
+	var counted []sdk.AccAddress
+	for _, signer := range sigTx.GetSigners() {
+		if exempt[signer.String()] || rld.gk.Authorized(ctx, signer) {
+			continue
+		}
+		if enforce && rld.tpk.GetTxCount(counterCtx, windowStart, signer) >= params.MaxTxsPerWindow {
+			return ctx, sdkerrors.Wrapf(
+				txpolicytypes.ErrRateLimited,
+				"%s sent more than %d txs within %d blocks", signer, params.MaxTxsPerWindow, params.WindowBlocks,
+			)
+		}
+		counted = append(counted, signer)
+	}
+	for _, signer := range counted {
+		rld.tpk.IncrementTxCount(counterCtx, windowStart, signer)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidateUpgradePlanDecorator rejects the software upgrade proposals whose plan
+// info defines invalid TIBC clients, so that they fail at submission rather
+// than at the upgrade height
+type ValidateUpgradePlanDecorator struct {
+	cdc codec.Codec
+}
+
+// NewValidateUpgradePlanDecorator returns an instance of ValidateUpgradePlanDecorator
+func NewValidateUpgradePlanDecorator(cdc codec.Codec) ValidateUpgradePlanDecorator {
+	return ValidateUpgradePlanDecorator{
+		cdc: cdc,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vupd ValidateUpgradePlanDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := vupd.validateMsgs(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgs also looks into the messages of the gov proposals and the ones
+// executed through authz
+func (vupd ValidateUpgradePlanDecorator) validateMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var (
+			innerMsgs []sdk.Msg
+			err       error
+		)
+		switch msg := msg.(type) {
+		case *upgradetypes.MsgSoftwareUpgrade:
+			err = vupd.validatePlan(msg.Plan)
+		case *govv1beta1.MsgSubmitProposal:
+			err = vupd.validateContent(msg.GetContent())
+		case *govv1.MsgExecLegacyContent:
+			var content govv1beta1.Content
+			if content, err = govv1.LegacyContentFromMessage(msg); err == nil {
+				err = vupd.validateContent(content)
+			}
+		case *govv1.MsgSubmitProposal:
+			innerMsgs, err = msg.GetMsgs()
+		case *authz.MsgExec:
+			innerMsgs, err = msg.GetMessages()
+		}
+		if err != nil {
+			return err
+		}
+		if err := vupd.validateMsgs(innerMsgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (vupd ValidateUpgradePlanDecorator) validateContent(content govv1beta1.Content) error {
+	if proposal, ok := content.(*upgradetypes.SoftwareUpgradeProposal); ok {
+		return vupd.validatePlan(proposal.Plan)
+	}
+	return nil
+}
+
+func (vupd ValidateUpgradePlanDecorator) validatePlan(plan upgradetypes.Plan) error {
+	if _, err := migratetibc.PlanInfoClients(vupd.cdc, plan.Info); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade plan %s: %s", plan.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	gridironante "github.com/furynet/furyhub/ante"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	txpolicytypes "github.com/furynet/furyhub/modules/txpolicy/types"
+	"github.com/furynet/furyhub/simapp"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *AnteTestSuite) SetupTest() {
+	suite.app = simapp.Setup(suite.T(), true)
+	suite.ctx = suite.app.BaseApp.NewContext(true, tmproto.Header{Height: 10})
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+// setRateLimit allows maxTxs txs per window of 10 blocks
+func (suite *AnteTestSuite) setRateLimit(maxTxs uint64, enforceInDeliverTx bool, exemptModules ...string) {
+	params := suite.app.TxPolicyKeeper.GetParams(suite.ctx)
+	params.MaxTxsPerWindow = maxTxs
+	params.WindowBlocks = 10
+	params.EnforceInDeliverTx = enforceInDeliverTx
+	params.RateLimitExemptModules = exemptModules
+	suite.app.TxPolicyKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *AnteTestSuite) newTx(signers ...sdk.AccAddress) sdk.Tx {
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(signers...)))
+	return txBuilder.GetTx()
+}
+
+func (suite *AnteTestSuite) anteHandle(ctx sdk.Context, tx sdk.Tx) error {
+	decorator := gridironante.NewRateLimitDecorator(suite.app.TxPolicyKeeper, suite.app.GuardianKeeper)
+	_, err := decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	return err
+}
+
+func (suite *AnteTestSuite) txCount(addr sdk.AccAddress) uint64 {
+	return suite.app.TxPolicyKeeper.GetTxCount(suite.ctx, 10, addr)
+}
+
+func (suite *AnteTestSuite) TestRateLimitCheckTx() {
+	suite.setRateLimit(2, false)
+	addr := sdk.AccAddress([]byte("rate_limited_address"))
+	tx := suite.newTx(addr)
+
+	suite.Require().NoError(suite.anteHandle(suite.ctx, tx))
+	suite.Require().NoError(suite.anteHandle(suite.ctx, tx))
+	err := suite.anteHandle(suite.ctx, tx)
+	suite.Require().ErrorIs(err, txpolicytypes.ErrRateLimited)
+	// the rejected tx takes up no slot
+	suite.Require().Equal(uint64(2), suite.txCount(addr))
+
+	// simulations are neither limited nor counted
+	decorator := gridironante.NewRateLimitDecorator(suite.app.TxPolicyKeeper, suite.app.GuardianKeeper)
+	_, err = decorator.AnteHandle(suite.ctx, tx, true, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.txCount(addr))
+}
+
+func (suite *AnteTestSuite) TestRateLimitDeliverTx() {
+	addr := sdk.AccAddress([]byte("rate_limited_address"))
+	tx := suite.newTx(addr)
+	deliverCtx := suite.ctx.WithIsCheckTx(false)
+
+	// the txs are only counted in DeliverTx by default
+	suite.setRateLimit(1, false)
+	suite.Require().NoError(suite.anteHandle(deliverCtx, tx))
+	suite.Require().NoError(suite.anteHandle(deliverCtx, tx))
+	suite.Require().Equal(uint64(2), suite.txCount(addr))
+	// CheckTx sees the txs counted in DeliverTx
+	suite.Require().ErrorIs(suite.anteHandle(suite.ctx, tx), txpolicytypes.ErrRateLimited)
+
+	suite.setRateLimit(2, true)
+	suite.Require().ErrorIs(suite.anteHandle(deliverCtx, tx), txpolicytypes.ErrRateLimited)
+	suite.Require().Equal(uint64(2), suite.txCount(addr))
+}
+
+func (suite *AnteTestSuite) TestRateLimitExempt() {
+	suite.setRateLimit(1, true, govtypes.ModuleName)
+	moduleAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	superAddr := sdk.AccAddress([]byte("super_address"))
+	suite.app.GuardianKeeper.AddSuper(suite.ctx, guardiantypes.NewSuper("test", guardiantypes.Ordinary, superAddr, superAddr))
+
+	for _, addr := range []sdk.AccAddress{moduleAddr, superAddr} {
+		tx := suite.newTx(addr)
+		suite.Require().NoError(suite.anteHandle(suite.ctx, tx))
+		suite.Require().NoError(suite.anteHandle(suite.ctx, tx))
+		suite.Require().Equal(uint64(0), suite.txCount(addr))
+	}
+}
+
+func (suite *AnteTestSuite) TestRateLimitWindowReset() {
+	suite.setRateLimit(1, true)
+	addr := sdk.AccAddress([]byte("rate_limited_address"))
+	tx := suite.newTx(addr)
+
+	suite.Require().NoError(suite.anteHandle(suite.ctx, tx))
+	// the window is tumbling, it lasts until the start of the next one
+	suite.Require().ErrorIs(suite.anteHandle(suite.ctx.WithBlockHeight(19), tx), txpolicytypes.ErrRateLimited)
+	suite.Require().NoError(suite.anteHandle(suite.ctx.WithBlockHeight(20), tx))
+	suite.Require().Equal(uint64(1), suite.app.TxPolicyKeeper.GetTxCount(suite.ctx, 20, addr))
+}
+
+func (suite *AnteTestSuite) TestRateLimitMultipleSigners() {
+	suite.setRateLimit(2, true)
+	addr1 := sdk.AccAddress([]byte("rate_limited_address1"))
+	addr2 := sdk.AccAddress([]byte("rate_limited_address2"))
+
+	// each signer of the tx is counted
+	suite.Require().NoError(suite.anteHandle(suite.ctx, suite.newTx(addr1, addr2)))
+	suite.Require().Equal(uint64(1), suite.txCount(addr1))
+	suite.Require().Equal(uint64(1), suite.txCount(addr2))
+
+	suite.Require().NoError(suite.anteHandle(suite.ctx, suite.newTx(addr2)))
+	// a tx rejected for one signer is counted for none
+	suite.Require().ErrorIs(suite.anteHandle(suite.ctx, suite.newTx(addr1, addr2)), txpolicytypes.ErrRateLimited)
+	suite.Require().Equal(uint64(1), suite.txCount(addr1))
+	suite.Require().Equal(uint64(2), suite.txCount(addr2))
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -128,12 +130,24 @@ import (
 	gridironante "github.com/furynet/furyhub/ante"
 	gridappparams "github.com/furynet/furyhub/app/params"
 	"github.com/furynet/furyhub/lite"
+	"github.com/furynet/furyhub/modules/did"
+	didkeeper "github.com/furynet/furyhub/modules/did/keeper"
+	didtypes "github.com/furynet/furyhub/modules/did/types"
 	"github.com/furynet/furyhub/modules/guardian"
 	guardiankeeper "github.com/furynet/furyhub/modules/guardian/keeper"
 	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/htlcasset"
+	htlcassetkeeper "github.com/furynet/furyhub/modules/htlcasset/keeper"
+	htlcassettypes "github.com/furynet/furyhub/modules/htlcasset/types"
 	"github.com/furynet/furyhub/modules/mint"
 	mintkeeper "github.com/furynet/furyhub/modules/mint/keeper"
 	minttypes "github.com/furynet/furyhub/modules/mint/types"
+	"github.com/furynet/furyhub/modules/ratelimit"
+	ratelimitkeeper "github.com/furynet/furyhub/modules/ratelimit/keeper"
+	ratelimittypes "github.com/furynet/furyhub/modules/ratelimit/types"
+	"github.com/furynet/furyhub/modules/txpolicy"
+	txpolicykeeper "github.com/furynet/furyhub/modules/txpolicy/keeper"
+	txpolicytypes "github.com/furynet/furyhub/modules/txpolicy/types"
 
 	"github.com/irisnet/irismod/modules/farm"
 	farmkeeper "github.com/irisnet/irismod/modules/farm/keeper"
@@ -199,13 +213,17 @@ var (
 		vesting.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
-		ica.AppModuleBasic{},
+		icaAppModuleBasic{},
 
 		guardian.AppModuleBasic{},
+		txpolicy.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
 		htlc.AppModuleBasic{},
+		htlcasset.AppModuleBasic{},
+		did.AppModuleBasic{},
 		coinswap.AppModuleBasic{},
 		service.AppModuleBasic{},
 		oracle.AppModuleBasic{},
@@ -296,11 +314,15 @@ type GridApp struct {
 	scopedTIBCMockKeeper capabilitykeeper.ScopedKeeper
 
 	GuardianKeeper        guardiankeeper.Keeper
+	TxPolicyKeeper        txpolicykeeper.Keeper
+	RateLimitKeeper       ratelimitkeeper.Keeper
 	TokenKeeper           tokenkeeper.Keeper
 	RecordKeeper          recordkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	MTKeeper              mtkeeper.Keeper
 	HTLCKeeper            htlckeeper.Keeper
+	HTLCAssetKeeper       htlcassetkeeper.Keeper
+	DIDKeeper             didkeeper.Keeper
 	CoinswapKeeper        coinswapkeeper.Keeper
 	ServiceKeeper         servicekeeper.Keeper
 	OracleKeeper          oraclekeeper.Keeper
@@ -311,7 +333,8 @@ type GridApp struct {
 	TIBCMTTransferKeeper  tibcmttransferkeeper.Keeper
 
 	// the module manager
-	mm *module.Manager
+	mm           *module.Manager
+	configurator module.Configurator
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -395,7 +418,7 @@ func NewGridApp(
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
 		farmtypes.StoreKey, feegrant.StoreKey, tibchost.StoreKey, tibcnfttypes.StoreKey, tibcmttypes.StoreKey, mttypes.StoreKey,
-		authzkeeper.StoreKey, icahosttypes.StoreKey,
+		authzkeeper.StoreKey, icahosttypes.StoreKey, txpolicytypes.StoreKey, ratelimittypes.StoreKey, didtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -444,7 +467,7 @@ func NewGridApp(
 		app.AccountKeeper,
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.AccountKeeper,
@@ -452,6 +475,17 @@ func NewGridApp(
 		app.BlockedModuleAccountAddrs(),
 	)
 
+	app.GuardianKeeper = guardiankeeper.NewKeeper(
+		appCodec,
+		keys[guardiantypes.StoreKey],
+		app.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// blocked addresses of the guardian module can't receive funds, the bank
+	// module itself keeps the base keeper as it registers its migrations on it
+	app.BankKeeper = guardiankeeper.NewSendRestrictedBankKeeper(bankKeeper, app.GuardianKeeper)
+
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -575,11 +609,21 @@ func NewGridApp(
 		app.TIBCKeeper.ClientKeeper,
 	)
 
+	// the rate limit keeper sits between the transfer module and core IBC
+	// to account the outgoing transfers
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.GuardianKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.IBCTransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.RateLimitKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -588,11 +632,12 @@ func NewGridApp(
 	)
 	transferModule := transfer.NewAppModule(app.IBCTransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.IBCTransferKeeper)
+	transferStack := ratelimit.NewIBCMiddleware(transferIBCModule, app.RateLimitKeeper)
 
 	// routerModule := router.NewAppModule(app.RouterKeeper, transferIBCModule)
 	// create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -614,9 +659,9 @@ func NewGridApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.GuardianKeeper = guardiankeeper.NewKeeper(
-		appCodec,
-		keys[guardiantypes.StoreKey],
+	app.TxPolicyKeeper = txpolicykeeper.NewKeeper(
+		keys[txpolicytypes.StoreKey],
+		app.GetSubspace(txpolicytypes.ModuleName),
 	)
 
 	app.TokenKeeper = tokenkeeper.NewKeeper(
@@ -633,6 +678,14 @@ func NewGridApp(
 		keys[recordtypes.StoreKey],
 	)
 
+	app.DIDKeeper = didkeeper.NewKeeper(
+		appCodec,
+		keys[didtypes.StoreKey],
+		app.AccountKeeper,
+		app.StakingKeeper,
+		app.RecordKeeper,
+	)
+
 	app.HTLCKeeper = htlckeeper.NewKeeper(
 		appCodec, keys[htlctypes.StoreKey],
 		app.GetSubspace(htlctypes.ModuleName),
@@ -641,6 +694,12 @@ func NewGridApp(
 		app.ModuleAccountAddrs(),
 	)
 
+	app.HTLCAssetKeeper = htlcassetkeeper.NewKeeper(
+		app.HTLCKeeper,
+		app.GuardianKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.CoinswapKeeper = coinswapkeeper.NewKeeper(
 		appCodec,
 		keys[coinswaptypes.StoreKey],
@@ -730,7 +789,7 @@ func NewGridApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		bank.NewAppModule(appCodec, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
@@ -749,11 +808,15 @@ func NewGridApp(
 		nfttransferModule,
 		mttransferModule,
 		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		txpolicy.NewAppModule(appCodec, app.TxPolicyKeeper),
+		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
-		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
+		did.NewRecordAppModule(record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper), app.RecordKeeper, app.DIDKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
 		mt.NewAppModule(appCodec, app.MTKeeper, app.AccountKeeper, app.BankKeeper),
 		htlc.NewAppModule(appCodec, app.HTLCKeeper, app.AccountKeeper, app.BankKeeper),
+		htlcasset.NewAppModule(appCodec, app.HTLCAssetKeeper),
+		did.NewAppModule(appCodec, app.DIDKeeper),
 		coinswap.NewAppModule(appCodec, app.CoinswapKeeper, app.AccountKeeper, app.BankKeeper),
 		service.NewAppModule(appCodec, app.ServiceKeeper, app.AccountKeeper, app.BankKeeper),
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
@@ -803,6 +866,10 @@ func NewGridApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txpolicytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		htlcassettypes.ModuleName,
+		didtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		//sdk module
@@ -842,6 +909,10 @@ func NewGridApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txpolicytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		htlcassettypes.ModuleName,
+		didtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -887,6 +958,10 @@ func NewGridApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txpolicytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		htlcassettypes.ModuleName,
+		didtypes.ModuleName,
 	)
 
 	cfg := module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -900,7 +975,7 @@ func NewGridApp(
 	// transactions
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		bank.NewAppModule(appCodec, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper),
@@ -944,10 +1019,12 @@ func NewGridApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
+			Codec:                app.appCodec,
 			BankKeeper:           app.BankKeeper,
 			TokenKeeper:          app.TokenKeeper,
 			OracleKeeper:         app.OracleKeeper,
 			GuardianKeeper:       app.GuardianKeeper,
+			TxPolicyKeeper:       app.TxPolicyKeeper,
 			BypassMinFeeMsgTypes: []string{},
 		},
 	)
@@ -961,6 +1038,7 @@ func NewGridApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	// Set software upgrade execution logic
+	app.configurator = cfg
 	app.RegisterUpgradePlan(cfg)
 
 	if loadLatest {
@@ -1016,9 +1094,51 @@ func (app *GridApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
-// LoadHeight loads a particular height
+// LoadHeight loads a particular height, failing with ErrHeightNotCommitted
+// if the height is beyond the latest committed one and with ErrHeightPruned
+// if a store no longer has its version. Any other failure, such as a corrupted
+// or unreadable store, is returned as is.
 func (app *GridApp) LoadHeight(height int64) error {
-	return app.LoadVersion(height)
+	latest := app.CommitMultiStore().LastCommitID().Version
+	if height <= 0 || height > latest {
+		return fmt.Errorf("%w: height %d, the latest committed height is %d", ErrHeightNotCommitted, height, latest)
+	}
+	if err := app.LoadVersion(height); err != nil {
+		if app.prunedAt(height) {
+			return fmt.Errorf(
+				"%w: the state at height %d is not available, the node must keep it according to its pruning settings: %s",
+				ErrHeightPruned, height, err,
+			)
+		}
+		return fmt.Errorf("failed to load height %d: %w", height, err)
+	}
+	return nil
+}
+
+// prunedAt tells whether the stores no longer have the version of the given
+// height. The stores are pruned together while a store added by an upgrade has
+// no version before it, so the height is pruned only if no store has it.
+func (app *GridApp) prunedAt(height int64) bool {
+	cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return false
+	}
+	// the failed load leaves no store mounted
+	if err := cms.LoadLatestVersion(); err != nil {
+		return false
+	}
+	found := false
+	for _, key := range app.keys {
+		store, ok := cms.GetCommitKVStore(key).(*iavlstore.Store)
+		if !ok {
+			continue
+		}
+		if store.VersionExists(height) {
+			return false
+		}
+		found = true
+	}
+	return found
 }
 
 // ModuleAccountAddrs returns all the app's module account addresses.
@@ -1111,6 +1231,10 @@ func (app *GridApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the DID resolution route, serving the DID documents in their
+	// JSON-LD representation.
+	did.AppModuleBasic{}.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		lite.RegisterSwaggerAPI(clientCtx, apiSvr.Router)
@@ -1160,6 +1284,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
 	paramsKeeper.Subspace(minttypes.ModuleName)
+	paramsKeeper.Subspace(txpolicytypes.ModuleName)
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())
@@ -41,4 +41,28 @@ const (
 	mtMsgEditMT        = "/irismod.mt.MsgEditMT"
 	mtMsgTransferMT    = "/irismod.mt.MsgTransferMT"
 	mtMsgBurnMT        = "/irismod.mt.MsgBurnMT"
+
+	tokenMsgIssueToken         = "/irismod.token.MsgIssueToken"
+	tokenMsgEditToken          = "/irismod.token.MsgEditToken"
+	tokenMsgMintToken          = "/irismod.token.MsgMintToken"
+	tokenMsgBurnToken          = "/irismod.token.MsgBurnToken"
+	tokenMsgTransferTokenOwner = "/irismod.token.MsgTransferTokenOwner"
+
+	coinswapMsgAddLiquidity              = "/irismod.coinswap.MsgAddLiquidity"
+	coinswapMsgAddUnilateralLiquidity    = "/irismod.coinswap.MsgAddUnilateralLiquidity"
+	coinswapMsgRemoveLiquidity           = "/irismod.coinswap.MsgRemoveLiquidity"
+	coinswapMsgRemoveUnilateralLiquidity = "/irismod.coinswap.MsgRemoveUnilateralLiquidity"
+	coinswapMsgSwapOrder                 = "/irismod.coinswap.MsgSwapOrder"
+
+	farmMsgCreatePool  = "/irismod.farm.MsgCreatePool"
+	farmMsgDestroyPool = "/irismod.farm.MsgDestroyPool"
+	farmMsgAdjustPool  = "/irismod.farm.MsgAdjustPool"
+	farmMsgStake       = "/irismod.farm.MsgStake"
+	farmMsgUnstake     = "/irismod.farm.MsgUnstake"
+	farmMsgHarvest     = "/irismod.farm.MsgHarvest"
+
+	htlcMsgCreateHTLC = "/irismod.htlc.MsgCreateHTLC"
+	htlcMsgClaimHTLC  = "/irismod.htlc.MsgClaimHTLC"
+
+	recordMsgCreateRecord = "/irismod.record.MsgCreateRecord"
 )
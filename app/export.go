@@ -2,13 +2,17 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -19,19 +23,42 @@ import (
 	"github.com/irisnet/irismod/modules/service"
 )
 
+var (
+	// ErrHeightNotCommitted is returned when loading a height the node hasn't committed yet
+	ErrHeightNotCommitted = errors.New("height not committed")
+	// ErrHeightPruned is returned when loading a height whose state has been pruned
+	ErrHeightPruned = errors.New("height pruned")
+)
+
+// ExportOptions tunes the export of the app state
+type ExportOptions struct {
+	// ForZeroHeight prepares the state for a chain starting at height zero
+	ForZeroHeight bool
+	// JailAllowedAddrs are the operator addresses of the validators left
+	// unjailed by a zero height export, none is jailed if empty
+	JailAllowedAddrs []string
+	// SkipInvariants skips the assertion of the invariants ahead of a zero
+	// height export
+	SkipInvariants bool
+	// KeepRewards keeps the reward accounting of a zero height export rather
+	// than withdrawing all rewards and commissions, its heights are moved to zero
+	KeepRewards bool
+	// Modules are the modules to export, all of them if empty
+	Modules []string
+	// Fork, if set, modifies the state to start a testnet from it
+	Fork *ForkOptions
+}
+
 // ExportAppStateAndValidators exports the state of the application for a genesis file.
 func (app *GridApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
 ) (servertypes.ExportedApp, error) {
-	// as if they could withdraw from the start of the next block
-	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
-
-	// We export at last height + 1, because that's the height at which
-	// Tendermint will start InitChain.
-	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
-		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	ctx, height, err := app.exportContext(ExportOptions{
+		ForZeroHeight:    forZeroHeight,
+		JailAllowedAddrs: jailAllowedAddrs,
+	})
+	if err != nil {
+		return servertypes.ExportedApp{}, err
 	}
 
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
@@ -49,98 +76,121 @@ func (app *GridApp) ExportAppStateAndValidators(
 	}, err
 }
 
-// prepare for fresh start at zero height
-// NOTE zero height genesis is a temporary feature which will be deprecated
-//      in favour of export at a block height
-func (app *GridApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
-	applyAllowedAddrs := false
-
-	// check if there is a allowed address list
-	if len(jailAllowedAddrs) > 0 {
-		applyAllowedAddrs = true
+// StreamAppStateAndValidators exports the state of the modules selected by
+// the options, or of all modules if none is, in alphabetical order. The
+// genesis state of each module is handed to write as soon as it is exported,
+// so that a single module is held in memory at a time. The returned
+// ExportedApp carries no AppState.
+func (app *GridApp) StreamAppStateAndValidators(
+	opts ExportOptions,
+	write func(moduleName string, state json.RawMessage) error,
+) (servertypes.ExportedApp, error) {
+	modules, err := app.ExportModules(opts.Modules)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
 	}
 
-	allowedAddrsMap := make(map[string]bool)
-
-	for _, addr := range jailAllowedAddrs {
-		_, err := sdk.ValAddressFromBech32(addr)
-		if err != nil {
-			log.Fatal(err)
+	ctx, height, err := app.exportContext(opts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	for _, moduleName := range modules {
+		if err := write(moduleName, app.mm.Modules[moduleName].ExportGenesis(ctx, app.appCodec)); err != nil {
+			return servertypes.ExportedApp{}, fmt.Errorf("failed to write %s genesis state: %w", moduleName, err)
 		}
-		allowedAddrsMap[addr] = true
 	}
 
-	/* Just to be safe, assert the invariants on current state. */
-	app.CrisisKeeper.AssertInvariants(ctx)
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	return servertypes.ExportedApp{
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
 
-	/* Handle fee distribution state. */
+// ExportModules returns the given module names sorted and deduplicated, or
+// all the exported modules if none is given, failing on unknown modules
+func (app *GridApp) ExportModules(modules []string) ([]string, error) {
+	if len(modules) == 0 {
+		modules = app.mm.OrderExportGenesis
+	}
 
-	// withdraw all validator commission
-	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
-		_, _ = app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
-		return false
-	})
+	known := make(map[string]bool, len(app.mm.OrderExportGenesis))
+	for _, moduleName := range app.mm.OrderExportGenesis {
+		known[moduleName] = true
+	}
 
-	// withdraw all delegator rewards
-	dels := app.StakingKeeper.GetAllDelegations(ctx)
-	for _, delegation := range dels {
-		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-		if err != nil {
-			panic(err)
+	seen := make(map[string]bool, len(modules))
+	selected := make([]string, 0, len(modules))
+	for _, moduleName := range modules {
+		if !known[moduleName] {
+			exported := append([]string{}, app.mm.OrderExportGenesis...)
+			sort.Strings(exported)
+			return nil, fmt.Errorf("unknown module %s, expected one of: %s", moduleName, strings.Join(exported, ", "))
 		}
-
-		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
-		if err != nil {
-			panic(err)
+		if !seen[moduleName] {
+			seen[moduleName] = true
+			selected = append(selected, moduleName)
 		}
-		_, _ = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
 	}
+	sort.Strings(selected)
+	return selected, nil
+}
 
-	// clear validator slash events
-	app.DistrKeeper.DeleteAllValidatorSlashEvents(ctx)
+// exportContext returns the context to export the state from and the height
+// the exported genesis starts at
+func (app *GridApp) exportContext(opts ExportOptions) (sdk.Context, int64, error) {
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 
-	// clear validator historical rewards
-	app.DistrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
+	if opts.Fork != nil {
+		if err := app.fork(ctx, *opts.Fork); err != nil {
+			return sdk.Context{}, 0, fmt.Errorf("failed to fork the state: %w", err)
+		}
+	}
 
-	// set context height to zero
-	height := ctx.BlockHeight()
-	ctx = ctx.WithBlockHeight(0)
+	// We export at last height + 1, because that's the height at which
+	// Tendermint will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if opts.ForZeroHeight {
+		height = 0
+		app.prepForZeroHeightGenesis(ctx, opts)
+	}
+	return ctx, height, nil
+}
 
-	// reinitialize all validators
-	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
-		// donate any unwithdrawn outstanding reward fraction tokens to the community pool
-		scraps := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, val.GetOperator())
-		feePool := app.DistrKeeper.GetFeePool(ctx)
-		feePool.CommunityPool = feePool.CommunityPool.Add(scraps...)
-		app.DistrKeeper.SetFeePool(ctx, feePool)
+// prepare for fresh start at zero height
+// NOTE zero height genesis is a temporary feature which will be deprecated
+//      in favour of export at a block height
+func (app *GridApp) prepForZeroHeightGenesis(ctx sdk.Context, opts ExportOptions) {
+	applyAllowedAddrs := false
 
-		if err := app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator()); err != nil {
-			panic(err)
-		}
-		return false
-	})
+	// check if there is a allowed address list
+	if len(opts.JailAllowedAddrs) > 0 {
+		applyAllowedAddrs = true
+	}
 
-	// reinitialize all delegations
-	for _, del := range dels {
-		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
-		if err != nil {
-			panic(err)
-		}
-		delAddr := sdk.MustAccAddressFromBech32(del.DelegatorAddress)
+	allowedAddrsMap := make(map[string]bool)
 
-		if err := app.DistrKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
-			// never called as BeforeDelegationCreated always returns nil
-			panic(fmt.Errorf("error while incrementing period: %w", err))
+	for _, addr := range opts.JailAllowedAddrs {
+		_, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			log.Fatal(err)
 		}
+		allowedAddrsMap[addr] = true
+	}
 
-		if err := app.DistrKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
-			// never called as AfterDelegationModified always returns nil
-			panic(fmt.Errorf("error while creating a new delegation period record: %w", err))
-		}
+	/* Just to be safe, assert the invariants on current state. */
+	if !opts.SkipInvariants {
+		app.CrisisKeeper.AssertInvariants(ctx)
 	}
 
-	// reset context height
-	ctx = ctx.WithBlockHeight(height)
+	/* Handle fee distribution state. */
+	if opts.KeepRewards {
+		app.rebaseRewardHeights(ctx)
+	} else {
+		app.resetRewards(ctx)
+	}
 
 	/* Handle staking state. */
 
@@ -212,6 +262,108 @@ func (app *GridApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 	service.PrepForZeroHeightGenesis(ctx, app.ServiceKeeper)
 }
 
+// resetRewards withdraws all rewards and commissions and restarts the reward
+// accounting at height zero
+func (app *GridApp) resetRewards(ctx sdk.Context) {
+	// withdraw all validator commission
+	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
+		_, _ = app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		return false
+	})
+
+	// withdraw all delegator rewards
+	dels := app.StakingKeeper.GetAllDelegations(ctx)
+	for _, delegation := range dels {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		_, _ = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	}
+
+	// clear validator slash events
+	app.DistrKeeper.DeleteAllValidatorSlashEvents(ctx)
+
+	// clear validator historical rewards
+	app.DistrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
+
+	// set context height to zero
+	ctx = ctx.WithBlockHeight(0)
+
+	// reinitialize all validators
+	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
+		// donate any unwithdrawn outstanding reward fraction tokens to the community pool
+		scraps := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, val.GetOperator())
+		feePool := app.DistrKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(scraps...)
+		app.DistrKeeper.SetFeePool(ctx, feePool)
+
+		if err := app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator()); err != nil {
+			panic(err)
+		}
+		return false
+	})
+
+	// reinitialize all delegations
+	for _, del := range dels {
+		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		delAddr := sdk.MustAccAddressFromBech32(del.DelegatorAddress)
+
+		if err := app.DistrKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
+			// never called as BeforeDelegationCreated always returns nil
+			panic(fmt.Errorf("error while incrementing period: %w", err))
+		}
+
+		if err := app.DistrKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+			// never called as AfterDelegationModified always returns nil
+			panic(fmt.Errorf("error while creating a new delegation period record: %w", err))
+		}
+	}
+}
+
+// rebaseRewardHeights keeps the reward accounting, moving the delegator
+// starting heights and the validator slash events to height zero. A slash
+// event only applies to the delegations started at an earlier period, so
+// moving all of them to height zero leaves the rewards unchanged.
+func (app *GridApp) rebaseRewardHeights(ctx sdk.Context) {
+	type startingInfo struct {
+		val  sdk.ValAddress
+		del  sdk.AccAddress
+		info distrtypes.DelegatorStartingInfo
+	}
+	var infos []startingInfo
+	app.DistrKeeper.IterateDelegatorStartingInfos(ctx, func(val sdk.ValAddress, del sdk.AccAddress, info distrtypes.DelegatorStartingInfo) (stop bool) {
+		info.Height = 0
+		infos = append(infos, startingInfo{val, del, info})
+		return false
+	})
+	for _, info := range infos {
+		app.DistrKeeper.SetDelegatorStartingInfo(ctx, info.val, info.del, info.info)
+	}
+
+	type slashEvent struct {
+		val   sdk.ValAddress
+		event distrtypes.ValidatorSlashEvent
+	}
+	var events []slashEvent
+	app.DistrKeeper.IterateValidatorSlashEvents(ctx, func(val sdk.ValAddress, _ uint64, event distrtypes.ValidatorSlashEvent) (stop bool) {
+		events = append(events, slashEvent{val, event})
+		return false
+	})
+	app.DistrKeeper.DeleteAllValidatorSlashEvents(ctx)
+	for _, e := range events {
+		app.DistrKeeper.SetValidatorSlashEvent(ctx, e.val, 0, e.event.ValidatorPeriod, e.event)
+	}
+}
+
 // ExportGenesis returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+)
+
+func TestLoadHeight(t *testing.T) {
+	db := dbm.NewMemDB()
+	gridApp := NewGridApp(
+		log.NewNopLogger(), db, nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(2, 10)),
+	)
+	for i := 0; i < 12; i++ {
+		gridApp.CommitMultiStore().Commit()
+	}
+
+	newApp := func() *GridApp {
+		return NewGridApp(
+			log.NewNopLogger(), db, nil, false, map[int64]bool{},
+			DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+		)
+	}
+
+	historical := newApp()
+	require.NoError(t, historical.LoadHeight(11))
+	require.Equal(t, int64(11), historical.LastBlockHeight())
+
+	require.ErrorIs(t, newApp().LoadHeight(13), ErrHeightNotCommitted)
+	require.ErrorIs(t, newApp().LoadHeight(0), ErrHeightNotCommitted)
+	require.ErrorIs(t, newApp().LoadHeight(1), ErrHeightPruned)
+
+	// a height whose commit info is lost is not reported as pruned
+	require.NoError(t, db.Delete([]byte("s/10")))
+	err := newApp().LoadHeight(10)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrHeightPruned)
+}
+
+func TestExportModules(t *testing.T) {
+	gridApp := NewGridApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+	)
+
+	all, err := gridApp.ExportModules(nil)
+	require.NoError(t, err)
+	require.Len(t, all, len(gridApp.mm.OrderExportGenesis))
+	require.True(t, sort.StringsAreSorted(all))
+
+	modules, err := gridApp.ExportModules([]string{"guardian", "bank", "guardian"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank", "guardian"}, modules)
+
+	_, err = gridApp.ExportModules([]string{"bank", "unknown"})
+	require.ErrorContains(t, err, "unknown module unknown")
+}
+
+func TestStreamAppStateAndValidators(t *testing.T) {
+	gridApp := NewGridApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+	)
+
+	var written []string
+	write := func(moduleName string, state json.RawMessage) error {
+		require.True(t, json.Valid(state))
+		written = append(written, moduleName)
+		return nil
+	}
+
+	exported, err := gridApp.StreamAppStateAndValidators(ExportOptions{Modules: []string{"ratelimit", "guardian"}}, write)
+	require.NoError(t, err)
+	require.Equal(t, []string{"guardian", "ratelimit"}, written)
+	require.Nil(t, exported.AppState)
+	require.Equal(t, int64(1), exported.Height)
+
+	written = nil
+	_, err = gridApp.StreamAppStateAndValidators(ExportOptions{Modules: []string{"unknown"}}, write)
+	require.Error(t, err)
+	require.Empty(t, written)
+
+	_, err = gridApp.StreamAppStateAndValidators(ExportOptions{Modules: []string{"guardian"}}, func(string, json.RawMessage) error {
+		return errors.New("disk full")
+	})
+	require.ErrorContains(t, err, "disk full")
+}
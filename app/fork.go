@@ -0,0 +1,196 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+// ForkOptions modifies the exported state to start a testnet from a copy of a chain
+type ForkOptions struct {
+	// Validators are the consensus keys taking over the bonded validators with
+	// the most power, in order, the other validators are jailed
+	Validators []cryptotypes.PubKey
+	// Balances are minted to the given accounts
+	Balances []banktypes.Balance
+}
+
+// fork applies the fork options to the state
+func (app *GridApp) fork(ctx sdk.Context, opts ForkOptions) error {
+	if len(opts.Validators) > 0 {
+		if err := app.replaceValidators(ctx, opts.Validators); err != nil {
+			return err
+		}
+	}
+
+	for _, balance := range opts.Balances {
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return fmt.Errorf("invalid account %s: %w", balance.Address, err)
+		}
+		if err := app.mintTo(ctx, addr, balance.Coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TestnetOptions modifies the state of a node to run it alone as a local testnet
+type TestnetOptions struct {
+	// Validator is the consensus key of the local validator, taking over the
+	// bonded validator with the most power while all the others are jailed
+	Validator cryptotypes.PubKey
+	// Delegator delegates nine times the tokens of the local validator to it,
+	// holding most of its voting power
+	Delegator sdk.AccAddress
+	// Balance is minted to the delegator on top of its delegation
+	Balance sdk.Coins
+	// Supers, if any, replace the genesis guardian supers
+	Supers []sdk.AccAddress
+	// VotingPeriod, if set, replaces the gov voting and max deposit periods
+	VotingPeriod time.Duration
+}
+
+// ApplyTestnet modifies the state so that the local validator runs the chain
+// on its own
+func (app *GridApp) ApplyTestnet(ctx sdk.Context, opts TestnetOptions) error {
+	if err := app.replaceValidators(ctx, []cryptotypes.PubKey{opts.Validator}); err != nil {
+		return err
+	}
+
+	consAddr := sdk.ConsAddress(opts.Validator.Address())
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return fmt.Errorf("no validator with key %s", consAddr)
+	}
+	stake := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), validator.Tokens.MulRaw(9))
+	if err := app.mintTo(ctx, opts.Delegator, opts.Balance.Add(stake)); err != nil {
+		return err
+	}
+	if _, err := app.StakingKeeper.Delegate(ctx, opts.Delegator, stake.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return fmt.Errorf("failed to delegate to the local validator: %w", err)
+	}
+	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return err
+	}
+	// the next block allocates the rewards of this one to its proposer
+	app.DistrKeeper.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	if len(opts.Supers) > 0 {
+		var genesisSupers []sdk.AccAddress
+		app.GuardianKeeper.IterateSupers(ctx, func(super guardiantypes.Super) bool {
+			if super.AccountType == guardiantypes.Genesis {
+				genesisSupers = append(genesisSupers, sdk.MustAccAddressFromBech32(super.Address))
+			}
+			return false
+		})
+		for _, addr := range genesisSupers {
+			app.GuardianKeeper.DeleteSuper(ctx, addr)
+		}
+		for _, addr := range opts.Supers {
+			app.GuardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("testnet", guardiantypes.Genesis, addr, addr))
+		}
+	}
+
+	if opts.VotingPeriod > 0 {
+		votingParams := app.GovKeeper.GetVotingParams(ctx)
+		votingParams.VotingPeriod = &opts.VotingPeriod
+		app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+		depositParams := app.GovKeeper.GetDepositParams(ctx)
+		depositParams.MaxDepositPeriod = &opts.VotingPeriod
+		app.GovKeeper.SetDepositParams(ctx, depositParams)
+	}
+	return nil
+}
+
+// mintTo mints the coins to the account
+func (app *GridApp) mintTo(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins); err != nil {
+		return fmt.Errorf("failed to fund account %s: %w", addr, err)
+	}
+	return nil
+}
+
+// replaceValidators hands the given consensus keys to the bonded validators
+// with the most power, keeping their stake, and jails all the other bonded
+// validators so that the given keys make up the whole validator set
+func (app *GridApp) replaceValidators(ctx sdk.Context, pubKeys []cryptotypes.PubKey) error {
+	bonded := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	if len(pubKeys) > len(bonded) {
+		return fmt.Errorf("%d validator keys given but only %d validators are bonded", len(pubKeys), len(bonded))
+	}
+
+	seen := make(map[string]bool, len(pubKeys))
+	for _, pk := range pubKeys {
+		consAddr := sdk.ConsAddress(pk.Address())
+		if seen[consAddr.String()] {
+			return fmt.Errorf("validator key %s given twice", consAddr)
+		}
+		seen[consAddr.String()] = true
+	}
+
+	for i, validator := range bonded {
+		if i < len(pubKeys) {
+			if err := app.replaceConsensusKey(ctx, validator, pubKeys[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		app.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	return err
+}
+
+// replaceConsensusKey sets the consensus key of the validator, carrying its
+// signing info over to the new key
+func (app *GridApp) replaceConsensusKey(ctx sdk.Context, validator stakingtypes.Validator, pk cryptotypes.PubKey) error {
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	newConsAddr := sdk.ConsAddress(pk.Address())
+	if owner, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, newConsAddr); found && !owner.GetOperator().Equals(validator.GetOperator()) {
+		return fmt.Errorf("validator key %s already belongs to validator %s", newConsAddr, owner.GetOperator())
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return err
+	}
+	validator.ConsensusPubkey = pkAny
+
+	ctx.KVStore(app.GetKey(stakingtypes.StoreKey)).Delete(stakingtypes.GetValidatorByConsAddrKey(oldConsAddr))
+	app.StakingKeeper.SetValidator(ctx, validator)
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	if info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr); found {
+		info.Address = newConsAddr.String()
+		info.IndexOffset = 0
+		info.MissedBlocksCounter = 0
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, newConsAddr, info)
+	}
+	return app.SlashingKeeper.AddPubkey(ctx, pk)
+}
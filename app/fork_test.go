@@ -0,0 +1,269 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+// setupForkApp initializes a chain with a delegator bonded to the given
+// number of validators, the first one having the most power, and commits it
+// once malleate, if any, has modified its state
+func setupForkApp(t *testing.T, numValidators int, malleate func(gridApp *GridApp, ctx sdk.Context)) (*GridApp, sdk.AccAddress) {
+	gridApp := NewGridApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+	)
+	cdc := gridApp.AppCodec()
+	genesis := NewDefaultGenesisState()
+
+	delegator := sdk.AccAddress([]byte("delegator___________"))
+	genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(authtypes.NewGenesisState(
+		authtypes.DefaultParams(),
+		authtypes.GenesisAccounts{authtypes.NewBaseAccountWithAddress(delegator)},
+	))
+
+	var (
+		validators  []stakingtypes.Validator
+		delegations []stakingtypes.Delegation
+		bonded      sdk.Coins
+	)
+	for i := 0; i < numValidators; i++ {
+		pk := ed25519.GenPrivKey().PubKey()
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+		require.NoError(t, err)
+		tokens := sdk.TokensFromConsensusPower(int64(numValidators-i), sdk.DefaultPowerReduction)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = tokens
+		validator.DelegatorShares = sdk.NewDecFromInt(tokens)
+		validators = append(validators, validator)
+		delegations = append(delegations, stakingtypes.NewDelegation(delegator, validator.GetOperator(), validator.DelegatorShares))
+		bonded = bonded.Add(sdk.NewCoin(sdk.DefaultBondDenom, tokens))
+	}
+	genesis[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), validators, delegations))
+
+	balances := []banktypes.Balance{{Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(), Coins: bonded}}
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(banktypes.NewGenesisState(banktypes.DefaultParams(), balances, bonded, nil))
+
+	// the initial supply of the native token is beyond the test limit
+	var token tokentypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[tokentypes.ModuleName], &token)
+	token.Tokens[0].InitialSupply = 0
+	genesis[tokentypes.ModuleName] = cdc.MustMarshalJSON(&token)
+
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+	gridApp.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	if malleate != nil {
+		malleate(gridApp, gridApp.NewContext(false, tmproto.Header{Height: 1}))
+	}
+	gridApp.Commit()
+	return gridApp, delegator
+}
+
+// streamAppState exports the whole app state with the given options
+func streamAppState(t *testing.T, gridApp *GridApp, opts ExportOptions) (map[string]json.RawMessage, []int64) {
+	appState := make(map[string]json.RawMessage)
+	exported, err := gridApp.StreamAppStateAndValidators(opts, func(moduleName string, state json.RawMessage) error {
+		appState[moduleName] = state
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), exported.Height)
+
+	powers := make([]int64, len(exported.Validators))
+	for i, validator := range exported.Validators {
+		powers[i] = validator.Power
+	}
+	return appState, powers
+}
+
+// requireGenesis checks a new chain starts from the given app state
+func requireGenesis(t *testing.T, appState map[string]json.RawMessage) {
+	bz, err := json.Marshal(appState)
+	require.NoError(t, err)
+	gridApp := NewGridApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+	)
+	require.NotPanics(t, func() {
+		gridApp.InitChain(abci.RequestInitChain{AppStateBytes: bz})
+	})
+}
+
+func TestForkValidators(t *testing.T) {
+	gridApp, _ := setupForkApp(t, 3, nil)
+	cdc := gridApp.AppCodec()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	funded := sdk.AccAddress([]byte("funded______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+
+	appState, powers := streamAppState(t, gridApp, ExportOptions{
+		ForZeroHeight: true,
+		Fork: &ForkOptions{
+			Validators: []cryptotypes.PubKey{pk},
+			Balances:   []banktypes.Balance{{Address: funded.String(), Coins: coins}},
+		},
+	})
+
+	// the validator with the most power is taken over by the new key
+	require.Equal(t, []int64{3}, powers)
+	var staking stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &staking)
+	jailed := 0
+	for _, validator := range staking.Validators {
+		require.NoError(t, validator.UnpackInterfaces(cdc))
+		if validator.IsBonded() {
+			consPk, err := validator.ConsPubKey()
+			require.NoError(t, err)
+			require.True(t, pk.Equals(consPk))
+			continue
+		}
+		require.True(t, validator.Jailed)
+		jailed++
+	}
+	require.Equal(t, 2, jailed)
+
+	var bank banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bank)
+	found := false
+	for _, balance := range bank.Balances {
+		if balance.Address == funded.String() {
+			require.Equal(t, coins, balance.Coins)
+			found = true
+		}
+	}
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction).AddRaw(500), bank.Supply.AmountOf(sdk.DefaultBondDenom))
+	requireGenesis(t, appState)
+
+	// more keys than bonded validators
+	gridApp, _ = setupForkApp(t, 1, nil)
+	_, err := gridApp.StreamAppStateAndValidators(ExportOptions{
+		ForZeroHeight: true,
+		Fork:          &ForkOptions{Validators: []cryptotypes.PubKey{pk, ed25519.GenPrivKey().PubKey()}},
+	}, func(string, json.RawMessage) error { return nil })
+	require.ErrorContains(t, err, "only 1 validators are bonded")
+}
+
+func TestExportKeepRewards(t *testing.T) {
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1000))
+
+	// allocates rewards to the first validator, without funding them when
+	// breaking the invariants
+	allocate := func(fund bool) func(gridApp *GridApp, ctx sdk.Context) {
+		return func(gridApp *GridApp, ctx sdk.Context) {
+			validator := gridApp.StakingKeeper.GetBondedValidatorsByPower(ctx)[0]
+			if fund {
+				coins, _ := rewards.TruncateDecimal()
+				require.NoError(t, gridApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+				require.NoError(t, gridApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+			}
+			gridApp.DistrKeeper.AllocateTokensToValidator(ctx, validator, rewards)
+		}
+	}
+
+	gridApp, _ := setupForkApp(t, 2, allocate(true))
+	appState, _ := streamAppState(t, gridApp, ExportOptions{ForZeroHeight: true, KeepRewards: true})
+	var distr distrtypes.GenesisState
+	gridApp.AppCodec().MustUnmarshalJSON(appState[distrtypes.ModuleName], &distr)
+	outstanding := sdk.NewDecCoins()
+	for _, o := range distr.OutstandingRewards {
+		outstanding = outstanding.Add(o.OutstandingRewards...)
+	}
+	require.Equal(t, rewards, outstanding)
+	require.NotEmpty(t, distr.DelegatorStartingInfos)
+	for _, info := range distr.DelegatorStartingInfos {
+		require.Zero(t, info.StartingInfo.Height)
+	}
+	requireGenesis(t, appState)
+
+	gridApp, delegator := setupForkApp(t, 2, allocate(true))
+	appState, _ = streamAppState(t, gridApp, ExportOptions{ForZeroHeight: true})
+	var bank banktypes.GenesisState
+	gridApp.AppCodec().MustUnmarshalJSON(appState[banktypes.ModuleName], &bank)
+	withdrawn := sdk.ZeroInt()
+	for _, balance := range bank.Balances {
+		if balance.Address == delegator.String() {
+			withdrawn = balance.Coins.AmountOf(sdk.DefaultBondDenom)
+		}
+	}
+	require.True(t, withdrawn.IsPositive())
+
+	gridApp, _ = setupForkApp(t, 2, allocate(false))
+	require.Panics(t, func() {
+		_, _ = gridApp.StreamAppStateAndValidators(ExportOptions{ForZeroHeight: true, KeepRewards: true}, func(string, json.RawMessage) error { return nil })
+	})
+
+	gridApp, _ = setupForkApp(t, 2, allocate(false))
+	streamAppState(t, gridApp, ExportOptions{ForZeroHeight: true, KeepRewards: true, SkipInvariants: true})
+}
+
+func TestApplyTestnet(t *testing.T) {
+	genesisSuper := sdk.AccAddress([]byte("genesis_super_______"))
+	ordinarySuper := sdk.AccAddress([]byte("ordinary_super______"))
+	gridApp, _ := setupForkApp(t, 3, func(gridApp *GridApp, ctx sdk.Context) {
+		gridApp.GuardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("genesis", guardiantypes.Genesis, genesisSuper, genesisSuper))
+		gridApp.GuardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("ordinary", guardiantypes.Ordinary, ordinarySuper, genesisSuper))
+	})
+	ctx := gridApp.NewUncachedContext(false, tmproto.Header{Height: 2})
+
+	pk := ed25519.GenPrivKey().PubKey()
+	local := sdk.AccAddress([]byte("local_______________"))
+	balance := sdk.NewCoins(sdk.NewInt64Coin("ufury", 1000))
+	require.NoError(t, gridApp.ApplyTestnet(ctx, TestnetOptions{
+		Validator:    pk,
+		Delegator:    local,
+		Balance:      balance,
+		Supers:       []sdk.AccAddress{local},
+		VotingPeriod: time.Minute,
+	}))
+
+	// the local validator is the only one left and the local account holds
+	// most of its voting power
+	bonded := gridApp.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, bonded, 1)
+	consPk, err := bonded[0].ConsPubKey()
+	require.NoError(t, err)
+	require.True(t, pk.Equals(consPk))
+	tokens := sdk.TokensFromConsensusPower(3, sdk.DefaultPowerReduction)
+	require.Equal(t, tokens.MulRaw(10), bonded[0].Tokens)
+	delegation, found := gridApp.StakingKeeper.GetDelegation(ctx, local, bonded[0].GetOperator())
+	require.True(t, found)
+	require.Equal(t, bonded[0].TokensFromShares(delegation.Shares).TruncateInt(), tokens.MulRaw(9))
+	require.Equal(t, balance, gridApp.BankKeeper.GetAllBalances(ctx, local))
+	require.Equal(t, sdk.ConsAddress(pk.Address()), gridApp.DistrKeeper.GetPreviousProposerConsAddr(ctx))
+
+	// only the genesis supers are replaced
+	_, found = gridApp.GuardianKeeper.GetSuper(ctx, genesisSuper)
+	require.False(t, found)
+	_, found = gridApp.GuardianKeeper.GetSuper(ctx, ordinarySuper)
+	require.True(t, found)
+	super, found := gridApp.GuardianKeeper.GetSuper(ctx, local)
+	require.True(t, found)
+	require.Equal(t, guardiantypes.Genesis, super.AccountType)
+
+	require.Equal(t, time.Minute, *gridApp.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	require.Equal(t, time.Minute, *gridApp.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod)
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+)
+
+// ICAHostAllowMessages returns the curated message types an interchain account
+// is allowed to execute on this chain. It is the default of the icahost
+// `AllowMessages` param, which can be updated afterwards by a param change
+// proposal on the `icahost` subspace.
+//
+// The v1.4 upgrade sets its own copy of the list, as released, in
+// app/upgrades/v1_4.go; that copy is history and must not follow the changes
+// of this one.
+func ICAHostAllowMessages() []string {
+	return []string{
+		authzMsgExec,
+		authzMsgGrant,
+		authzMsgRevoke,
+		bankMsgSend,
+		bankMsgMultiSend,
+		distrMsgSetWithdrawAddr,
+		distrMsgWithdrawValidatorCommission,
+		distrMsgFundCommunityPool,
+		distrMsgWithdrawDelegatorReward,
+		feegrantMsgGrantAllowance,
+		feegrantMsgRevokeAllowance,
+		legacyGovMsgVoteWeighted,
+		legacyGovMsgSubmitProposal,
+		legacyGovMsgDeposit,
+		legacyGovMsgVote,
+		govMsgVoteWeighted,
+		govMsgSubmitProposal,
+		govMsgDeposit,
+		govMsgVote,
+		stakingMsgEditValidator,
+		stakingMsgDelegate,
+		stakingMsgUndelegate,
+		stakingMsgBeginRedelegate,
+		stakingMsgCreateValidator,
+		vestingMsgCreateVestingAccount,
+		ibcMsgTransfer,
+
+		nftMsgIssueDenom,
+		nftMsgTransferDenom,
+		nftMsgMintNFT,
+		nftMsgEditNFT,
+		nftMsgTransferNFT,
+		nftMsgBurnNFT,
+
+		mtMsgIssueDenom,
+		mtMsgTransferDenom,
+		mtMsgMintMT,
+		mtMsgEditMT,
+		mtMsgTransferMT,
+		mtMsgBurnMT,
+
+		tokenMsgIssueToken,
+		tokenMsgEditToken,
+		tokenMsgMintToken,
+		tokenMsgBurnToken,
+		tokenMsgTransferTokenOwner,
+
+		coinswapMsgAddLiquidity,
+		coinswapMsgAddUnilateralLiquidity,
+		coinswapMsgRemoveLiquidity,
+		coinswapMsgRemoveUnilateralLiquidity,
+		coinswapMsgSwapOrder,
+
+		farmMsgCreatePool,
+		farmMsgDestroyPool,
+		farmMsgAdjustPool,
+		farmMsgStake,
+		farmMsgUnstake,
+		farmMsgHarvest,
+
+		htlcMsgCreateHTLC,
+		htlcMsgClaimHTLC,
+
+		recordMsgCreateRecord,
+	}
+}
+
+// icaAppModuleBasic overrides the default genesis of the interchain accounts
+// module with the curated host allow-list
+type icaAppModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis state of the interchain accounts module
+func (icaAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(NewICAGenesisState())
+}
+
+// NewICAGenesisState returns the interchain accounts genesis state with the
+// host submodule enabled for the curated message types
+func NewICAGenesisState() *icatypes.GenesisState {
+	genesis := icatypes.DefaultGenesis()
+	genesis.HostGenesisState.Params.HostEnabled = true
+	genesis.HostGenesisState.Params.AllowMessages = ICAHostAllowMessages()
+	return genesis
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestICAHostAllowMessages(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+
+	registered := make(map[string]bool)
+	for _, typeURL := range encCfg.InterfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		registered[typeURL] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, typeURL := range ICAHostAllowMessages() {
+		require.True(t, registered[typeURL], "%s is not a registered message", typeURL)
+		require.False(t, seen[typeURL], "%s is duplicated", typeURL)
+		seen[typeURL] = true
+	}
+
+	genesis := NewICAGenesisState()
+	require.NoError(t, genesis.Validate())
+	require.True(t, genesis.HostGenesisState.Params.HostEnabled)
+}
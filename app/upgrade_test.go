@@ -0,0 +1,126 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	"github.com/furynet/furyhub/app/upgrades"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	gridApp := NewGridApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+	)
+
+	super := sdk.AccAddress([]byte("dry-run-super"))
+	u := upgrades.Upgrade{
+		Name:             "dry-run",
+		StoreUpgrades:    store.StoreUpgrades{Added: []string{"dry-run"}},
+		ExpectedVersions: module.VersionMap{guardiantypes.ModuleName: 1},
+		Handler: func(ctx sdk.Context, box upgrades.Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			gridApp.GuardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("dry run", guardiantypes.Ordinary, super, super))
+			fromVM[guardiantypes.ModuleName]++
+			return fromVM, nil
+		},
+	}
+	require.NoError(t, gridApp.LoadLatestVersionWithUpgrade(u))
+
+	header := tmproto.Header{Height: gridApp.LastBlockHeight() + 1}
+	report, err := gridApp.DryRunUpgrade(u, header, "")
+	require.NoError(t, err)
+	require.Equal(t, "dry-run", report.Name)
+	require.Equal(t, int64(1), report.Height)
+	require.Equal(t, []string{"dry-run"}, report.StoreUpgrades.Added)
+	require.Empty(t, report.FromVersions)
+	require.Equal(t, uint64(2), report.ToVersions[guardiantypes.ModuleName])
+	require.Equal(t, gridApp.mm.GetVersionMap(), report.ExpectedVersions)
+
+	// nothing is committed
+	ctx := gridApp.NewUncachedContext(false, header)
+	require.False(t, gridApp.GuardianKeeper.Authorized(ctx, super))
+
+	// the plan info defines clients only for the upgrades opting in
+	_, err = gridApp.DryRunUpgrade(u, header, `{"tibc_clients":{}}`)
+	require.NoError(t, err)
+	u.PlanInfoClients = true
+	_, err = gridApp.DryRunUpgrade(u, header, `{"tibc_clients":{}}`)
+	require.ErrorContains(t, err, "invalid tibc client definitions")
+
+	u.Handler = func(sdk.Context, upgrades.Toolbox, sdkupgrade.Plan, module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("boom")
+	}
+	_, err = gridApp.DryRunUpgrade(u, header, "")
+	require.ErrorContains(t, err, "boom")
+
+	u.Handler = func(sdk.Context, upgrades.Toolbox, sdkupgrade.Plan, module.VersionMap) (module.VersionMap, error) {
+		panic("boom")
+	}
+	_, err = gridApp.DryRunUpgrade(u, header, "")
+	require.ErrorContains(t, err, "panicked")
+}
+
+func TestUpgradeChecks(t *testing.T) {
+	gridApp := NewGridApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{},
+	)
+	header := tmproto.Header{Height: gridApp.LastBlockHeight() + 1}
+
+	u := upgrades.Upgrade{
+		Name: "checked",
+		Handler: func(ctx sdk.Context, box upgrades.Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return fromVM, nil
+		},
+		Checks: []upgrades.Check{
+			upgrades.SupplyUnchangedCheck(),
+			upgrades.HTLCEscrowCheck(),
+			upgrades.EmptyAccountCheck("service_tax_account"),
+		},
+	}
+	_, err := gridApp.DryRunUpgrade(u, header, "")
+	require.NoError(t, err)
+
+	u.Handler = func(ctx sdk.Context, box upgrades.Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("ufury", 100))
+		if err := box.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+			return nil, err
+		}
+		return fromVM, box.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, htlctypes.ModuleName, coins)
+	}
+	_, err = gridApp.DryRunUpgrade(u, header, "")
+	require.ErrorIs(t, err, upgrades.ErrCheckFailed)
+	require.ErrorContains(t, err, "supply-unchanged")
+	require.ErrorContains(t, err, "htlc-escrow")
+	require.NotContains(t, err.Error(), "empty-account")
+
+	// the log only checks of the shipped upgrades never fail them
+	u.Checks = upgrades.LogOnly(
+		upgrades.SupplyUnchangedCheck(),
+		upgrades.HTLCEscrowCheck(),
+		upgrades.Check{
+			Name: "panicking",
+			Snapshot: func(sdk.Context, upgrades.Toolbox) (interface{}, error) {
+				panic("boom")
+			},
+			Verify: func(sdk.Context, upgrades.Toolbox, interface{}) error { return nil },
+		},
+	)
+	_, err = gridApp.DryRunUpgrade(u, header, "")
+	require.NoError(t, err)
+}
@@ -0,0 +1,193 @@
+package upgrades
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+)
+
+// ErrCheckFailed is returned when the state left by an upgrade handler fails a verification check
+var ErrCheckFailed = errors.New("upgrade verification failed")
+
+// Check verifies the state left by an upgrade handler
+type Check struct {
+	// Name identifies the check in the logs and errors
+	Name string
+	// Snapshot, if set, reads the state before the handler runs, its result
+	// is given to Verify
+	Snapshot func(ctx sdk.Context, box Toolbox) (interface{}, error)
+	// Verify returns an error if the state after the handler is inconsistent
+	Verify func(ctx sdk.Context, box Toolbox, snapshot interface{}) error
+	// LogOnly checks only log their failures instead of failing the upgrade
+	LogOnly bool
+}
+
+// LogOnly returns the checks in log only mode, for the upgrades already run
+// on mainnet: a node replaying them from genesis must write the state they
+// shipped with, whether or not the checks added since hold on it.
+func LogOnly(checks ...Check) []Check {
+	for i := range checks {
+		checks[i].LogOnly = true
+	}
+	return checks
+}
+
+// skippedCheck is the snapshot of a log only check whose snapshot failed
+type skippedCheck struct{}
+
+// snapshotChecks reads the state needed by the checks ahead of the handler,
+// the log only checks whose snapshot fails being skipped
+func snapshotChecks(ctx sdk.Context, box Toolbox, checks []Check) ([]interface{}, error) {
+	logger := ctx.Logger().With("module", "upgrades")
+
+	snapshots := make([]interface{}, len(checks))
+	for i, check := range checks {
+		if check.Snapshot == nil {
+			continue
+		}
+		snapshot, err := runCheck(check, func() (interface{}, error) { return check.Snapshot(ctx, box) })
+		if err != nil {
+			if !check.LogOnly {
+				return nil, fmt.Errorf("check %s: %w", check.Name, err)
+			}
+			logger.Error("upgrade verification check skipped", "check", check.Name, "err", err)
+			snapshot = skippedCheck{}
+		}
+		snapshots[i] = snapshot
+	}
+	return snapshots, nil
+}
+
+// verifyChecks runs all the checks and reports every failure at once, the
+// failures of the log only checks being logged only
+func verifyChecks(ctx sdk.Context, box Toolbox, checks []Check, snapshots []interface{}) error {
+	logger := ctx.Logger().With("module", "upgrades")
+
+	var failures []string
+	for i, check := range checks {
+		if _, ok := snapshots[i].(skippedCheck); ok {
+			continue
+		}
+		_, err := runCheck(check, func() (interface{}, error) { return nil, check.Verify(ctx, box, snapshots[i]) })
+		if err != nil {
+			logger.Error("upgrade verification check failed", "check", check.Name, "log_only", check.LogOnly, "err", err)
+			if !check.LogOnly {
+				failures = append(failures, fmt.Sprintf("%s: %s", check.Name, err))
+			}
+			continue
+		}
+		logger.Info("upgrade verification check passed", "check", check.Name)
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%w: %s", ErrCheckFailed, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// runCheck runs a step of the check, the panics of the log only checks being
+// returned as errors
+func runCheck(check Check, step func() (interface{}, error)) (res interface{}, err error) {
+	if check.LogOnly {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panicked: %v", r)
+			}
+		}()
+	}
+	return step()
+}
+
+// SupplyUnchangedCheck verifies the total supply is the same before and after the handler
+func SupplyUnchangedCheck() Check {
+	totalSupply := func(ctx sdk.Context, box Toolbox) sdk.Coins {
+		var supply sdk.Coins
+		box.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			supply = append(supply, coin)
+			return false
+		})
+		return supply
+	}
+	return Check{
+		Name: "supply-unchanged",
+		Snapshot: func(ctx sdk.Context, box Toolbox) (interface{}, error) {
+			return totalSupply(ctx, box), nil
+		},
+		Verify: func(ctx sdk.Context, box Toolbox, snapshot interface{}) error {
+			before, after := snapshot.(sdk.Coins), totalSupply(ctx, box)
+			if !coinsEqual(before, after) {
+				return fmt.Errorf("total supply changed from %s to %s", before, after)
+			}
+			return nil
+		},
+	}
+}
+
+// HTLCEscrowCheck verifies the balance of the htlc module account equals the
+// amount locked by the open HTLCs, that is all but the incoming HTLTs
+func HTLCEscrowCheck() Check {
+	return Check{
+		Name: "htlc-escrow",
+		Verify: func(ctx sdk.Context, box Toolbox, _ interface{}) error {
+			var locked sdk.Coins
+			box.HTLCKeeper.IterateHTLCs(ctx, func(_ tmbytes.HexBytes, h htlctypes.HTLC) bool {
+				if h.State == htlctypes.Open && !(h.Transfer && h.Direction == htlctypes.Incoming) {
+					locked = locked.Add(h.Amount...)
+				}
+				return false
+			})
+
+			escrow := box.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(htlctypes.ModuleName))
+			if !coinsEqual(escrow, locked) {
+				return fmt.Errorf("htlc escrow balance %s does not match the open htlc amount %s", escrow, locked)
+			}
+			return nil
+		},
+	}
+}
+
+// EmptyAccountCheck verifies the account derived from the given name holds no
+// balance, such as the legacy service tax account
+func EmptyAccountCheck(name string) Check {
+	return Check{
+		Name: fmt.Sprintf("empty-account-%s", name),
+		Verify: func(ctx sdk.Context, box Toolbox, _ interface{}) error {
+			addr := sdk.AccAddress(crypto.AddressHash([]byte(name)))
+			if balances := box.BankKeeper.GetAllBalances(ctx, addr); !balances.IsZero() {
+				return fmt.Errorf("account %s still holds %s", addr, balances)
+			}
+			return nil
+		},
+	}
+}
+
+// InvariantsCheck verifies all the invariants registered in the crisis keeper hold
+func InvariantsCheck() Check {
+	return Check{
+		Name: "invariants",
+		Verify: func(ctx sdk.Context, box Toolbox, _ interface{}) error {
+			var broken []string
+			for _, route := range box.CrisisKeeper.Routes() {
+				if msg, stop := route.Invar(ctx); stop {
+					broken = append(broken, fmt.Sprintf("%s: %s", route.FullRoute(), strings.TrimSpace(msg)))
+				}
+			}
+			if len(broken) > 0 {
+				return fmt.Errorf("broken invariants: %s", strings.Join(broken, "; "))
+			}
+			return nil
+		},
+	}
+}
+
+// coinsEqual compares two sets of coins without panicking on different denoms
+func coinsEqual(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
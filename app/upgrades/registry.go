@@ -0,0 +1,33 @@
+package upgrades
+
+import (
+	"fmt"
+)
+
+// Upgrades are all the upgrades known to the app, in the order they were released
+var Upgrades = []Upgrade{
+	V1_1,
+	V1_2,
+	V1_3,
+	V1_4,
+	V1_5,
+}
+
+// Get returns the upgrade with the given name
+func Get(name string) (Upgrade, error) {
+	for _, u := range Upgrades {
+		if u.Name == name {
+			return u, nil
+		}
+	}
+	return Upgrade{}, fmt.Errorf("unknown upgrade %s", name)
+}
+
+// Names returns the names of all upgrades
+func Names() []string {
+	names := make([]string, len(Upgrades))
+	for i, u := range Upgrades {
+		names[i] = u.Name
+	}
+	return names
+}
@@ -0,0 +1,46 @@
+package upgrades
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgrades(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, u := range Upgrades {
+		require.NoError(t, u.Validate())
+		require.False(t, seen[u.Name], "upgrade %s is registered twice", u.Name)
+		seen[u.Name] = true
+
+		got, err := Get(u.Name)
+		require.NoError(t, err)
+		require.Equal(t, u.Name, got.Name)
+	}
+
+	// the upgrades which ran on mainnet before the checks were added must
+	// replay as they shipped
+	for _, u := range []Upgrade{V1_1, V1_2} {
+		for _, check := range u.Checks {
+			require.True(t, check.LogOnly, "check %s of upgrade %s", check.Name, u.Name)
+		}
+	}
+
+	_, err := Get("unknown")
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	require.Error(t, Upgrade{}.Validate())
+	require.Error(t, Upgrade{Name: "v0"}.Validate())
+
+	u := V1_3
+	u.StoreUpgrades.Deleted = u.StoreUpgrades.Added
+	require.Error(t, u.Validate())
+
+	u = V1_1
+	u.Checks = append(u.Checks, InvariantsCheck())
+	require.Error(t, u.Validate())
+	u.Checks = []Check{{Name: "no-verify"}}
+	require.Error(t, u.Validate())
+}
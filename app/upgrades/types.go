@@ -0,0 +1,132 @@
+package upgrades
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	htlckeeper "github.com/irisnet/irismod/modules/htlc/keeper"
+	servicekeeper "github.com/irisnet/irismod/modules/service/keeper"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	tibckeeper "github.com/bianjieai/tibc-go/modules/tibc/core/keeper"
+
+	migratetibc "github.com/furynet/furyhub/migrate/tibc"
+)
+
+// Upgrade defines a software upgrade of the app
+type Upgrade struct {
+	// Name is the name of the upgrade plan
+	Name string
+	// StoreUpgrades are applied to the multistore at the upgrade height
+	StoreUpgrades store.StoreUpgrades
+	// ExpectedVersions pins the consensus versions of the listed modules
+	// before the handler runs, for modules the version map of the store has
+	// no record of or whose migrations must be skipped
+	ExpectedVersions module.VersionMap
+	// Handler performs the state changes of the upgrade and returns the
+	// resulting version map, usually by running the module migrations
+	Handler func(ctx sdk.Context, box Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error)
+	// PlanInfoClients creates the TIBC clients defined in the info of the
+	// plan under migratetibc.PlanInfoKey after the handler, ahead of the
+	// checks. The upgrades run before the plan info could define clients
+	// leave it unset, so that their replay writes the state they shipped with.
+	PlanInfoClients bool
+	// Checks verify the state left by the handler, the upgrade fails if any
+	// of them does not pass, unless it is log only
+	Checks []Check
+}
+
+// Toolbox gives the upgrade handlers access to the app
+type Toolbox struct {
+	AppCodec      codec.Codec
+	ModuleManager *module.Manager
+	Configurator  module.Configurator
+	NativeToken   tokentypes.Token
+
+	BankKeeper    bankkeeper.Keeper
+	CrisisKeeper  *crisiskeeper.Keeper
+	HTLCKeeper    htlckeeper.Keeper
+	ServiceKeeper servicekeeper.Keeper
+	TIBCKeeper    *tibckeeper.Keeper
+
+	GetKey func(storeKey string) *store.KVStoreKey
+}
+
+// RunMigrations runs the migrations of all modules from the given version map
+func (box Toolbox) RunMigrations(ctx sdk.Context, fromVM module.VersionMap) (module.VersionMap, error) {
+	return box.ModuleManager.RunMigrations(ctx, box.Configurator, fromVM)
+}
+
+// UpgradeHandler returns the handler to register in the upgrade keeper. The
+// TIBC clients defined in the info of the plan are created if the upgrade
+// opts in with PlanInfoClients. The plan info of the upgrade proposals is
+// validated at submission by the ante handler.
+func (u Upgrade) UpgradeHandler(box Toolbox) sdkupgrade.UpgradeHandler {
+	return func(ctx sdk.Context, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		snapshots, err := snapshotChecks(ctx, box, u.Checks)
+		if err != nil {
+			return nil, err
+		}
+
+		for moduleName, version := range u.ExpectedVersions {
+			fromVM[moduleName] = version
+		}
+		toVM, err := u.Handler(ctx, box, plan, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// create the tibc clients carried by the plan
+		if u.PlanInfoClients {
+			clients, err := migratetibc.PlanInfoClients(box.AppCodec, plan.Info)
+			if err != nil {
+				return nil, err
+			}
+			if err := migratetibc.CreateClients(ctx, box.TIBCKeeper.ClientKeeper, clients); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := verifyChecks(ctx, box, u.Checks, snapshots); err != nil {
+			return nil, fmt.Errorf("upgrade %s: %w", u.Name, err)
+		}
+		return toVM, nil
+	}
+}
+
+// Validate checks the upgrade is well formed
+func (u Upgrade) Validate() error {
+	if len(u.Name) == 0 {
+		return fmt.Errorf("upgrade name missing")
+	}
+	if u.Handler == nil {
+		return fmt.Errorf("upgrade %s has no handler", u.Name)
+	}
+	checks := make(map[string]bool)
+	for _, check := range u.Checks {
+		if len(check.Name) == 0 || check.Verify == nil {
+			return fmt.Errorf("upgrade %s has a check without name or verification", u.Name)
+		}
+		if checks[check.Name] {
+			return fmt.Errorf("upgrade %s declares check %s twice", u.Name, check.Name)
+		}
+		checks[check.Name] = true
+	}
+	seen := make(map[string]bool)
+	for _, names := range [][]string{u.StoreUpgrades.Added, u.StoreUpgrades.Deleted} {
+		for _, name := range names {
+			if seen[name] {
+				return fmt.Errorf("upgrade %s touches store %s twice", u.Name, name)
+			}
+			seen[name] = true
+		}
+	}
+	return nil
+}
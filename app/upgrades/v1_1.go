@@ -0,0 +1,36 @@
+package upgrades
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	migratehtlc "github.com/furynet/furyhub/migrate/htlc"
+	migrateservice "github.com/furynet/furyhub/migrate/service"
+)
+
+// V1_1 migrates the htlc and service modules
+var V1_1 = Upgrade{
+	Name: "v1.1",
+	Handler: func(ctx sdk.Context, box Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// migrate htlc
+		if _, err := migratehtlc.Migrate(ctx, box.AppCodec, box.HTLCKeeper, box.BankKeeper, box.GetKey(htlctypes.StoreKey)); err != nil {
+			panic(err)
+		}
+		// migrate service
+		if err := migrateservice.Migrate(ctx, box.ServiceKeeper, box.BankKeeper); err != nil {
+			panic(err)
+		}
+
+		return fromVM, nil
+	},
+	// the upgrade ran on mainnet before the checks were added
+	Checks: LogOnly(
+		HTLCEscrowCheck(),
+		EmptyAccountCheck(migrateservice.TaxAccName),
+		SupplyUnchangedCheck(),
+		InvariantsCheck(),
+	),
+}
@@ -0,0 +1,118 @@
+package upgrades
+
+import (
+	sdkmath "cosmossdk.io/math"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	ibchost "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	farmtypes "github.com/irisnet/irismod/modules/farm/types"
+	"github.com/irisnet/irismod/modules/htlc"
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+	nftmodule "github.com/irisnet/irismod/modules/nft/module"
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	"github.com/irisnet/irismod/modules/oracle"
+	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
+	"github.com/irisnet/irismod/modules/random"
+	randomtypes "github.com/irisnet/irismod/modules/random/types"
+	"github.com/irisnet/irismod/modules/record"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+	"github.com/irisnet/irismod/modules/service"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	"github.com/irisnet/irismod/modules/token"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	tibcnfttypes "github.com/bianjieai/tibc-go/modules/tibc/apps/nft_transfer/types"
+	tibcclienttypes "github.com/bianjieai/tibc-go/modules/tibc/core/02-client/types"
+	tibchost "github.com/bianjieai/tibc-go/modules/tibc/core/24-host"
+
+	migratetibc "github.com/furynet/furyhub/migrate/tibc"
+	"github.com/furynet/furyhub/modules/guardian"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/mint"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+// V1_2 adds the farm, feegrant and tibc modules and introduces the module
+// version map, the modules existing before it are pinned at their versions
+var V1_2 = Upgrade{
+	Name: "v1.2",
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{farmtypes.StoreKey, feegrant.StoreKey, tibchost.StoreKey, tibcnfttypes.StoreKey},
+	},
+	ExpectedVersions: module.VersionMap{
+		authtypes.ModuleName:       1,
+		banktypes.ModuleName:       1,
+		stakingtypes.ModuleName:    1,
+		govtypes.ModuleName:        1,
+		distrtypes.ModuleName:      1,
+		slashingtypes.ModuleName:   1,
+		coinswaptypes.ModuleName:   1,
+		ibchost.ModuleName:         1,
+		capabilitytypes.ModuleName: capability.AppModule{}.ConsensusVersion(),
+		genutiltypes.ModuleName:    genutil.AppModule{}.ConsensusVersion(),
+		minttypes.ModuleName:       mint.AppModule{}.ConsensusVersion(),
+		paramstypes.ModuleName:     params.AppModule{}.ConsensusVersion(),
+		crisistypes.ModuleName:     crisis.AppModule{}.ConsensusVersion(),
+		upgradetypes.ModuleName:    crisis.AppModule{}.ConsensusVersion(),
+		evidencetypes.ModuleName:   evidence.AppModule{}.ConsensusVersion(),
+		feegrant.ModuleName:        feegrantmodule.AppModule{}.ConsensusVersion(),
+		guardiantypes.ModuleName:   guardian.AppModule{}.ConsensusVersion(),
+		tokentypes.ModuleName:      token.AppModule{}.ConsensusVersion(),
+		recordtypes.ModuleName:     record.AppModule{}.ConsensusVersion(),
+		nfttypes.ModuleName:        nftmodule.AppModule{}.ConsensusVersion(),
+		htlctypes.ModuleName:       htlc.AppModule{}.ConsensusVersion(),
+		servicetypes.ModuleName:    service.AppModule{}.ConsensusVersion(),
+		oracletypes.ModuleName:     oracle.AppModule{}.ConsensusVersion(),
+		randomtypes.ModuleName:     random.AppModule{}.ConsensusVersion(),
+	},
+	Handler: func(ctx sdk.Context, box Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// init farm params
+		amount := sdkmath.NewIntWithDecimal(1000, int(box.NativeToken.Scale))
+		farmtypes.SetDefaultGenesisState(farmtypes.GenesisState{
+			Params: farmtypes.Params{
+				PoolCreationFee:     sdk.NewCoin(box.NativeToken.MinUnit, amount),
+				MaxRewardCategories: 2,
+			}},
+		)
+		tibcclienttypes.SetDefaultGenesisState(tibcclienttypes.GenesisState{
+			NativeChainName: "gridiron-mainnet",
+		})
+
+		if err := migratetibc.CreateClient(ctx,
+			box.AppCodec,
+			"v1.2",
+			box.TIBCKeeper.ClientKeeper,
+		); err != nil {
+			return nil, err
+		}
+		return box.RunMigrations(ctx, fromVM)
+	},
+	// the upgrade ran on mainnet before the checks were added
+	Checks: LogOnly(
+		InvariantsCheck(),
+	),
+}
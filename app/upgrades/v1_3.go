@@ -0,0 +1,32 @@
+package upgrades
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	mttypes "github.com/irisnet/irismod/modules/mt/types"
+
+	tibcmttypes "github.com/bianjieai/tibc-go/modules/tibc/apps/mt_transfer/types"
+
+	migratetibc "github.com/furynet/furyhub/migrate/tibc"
+)
+
+// V1_3 adds the mt and tibc mt transfer modules
+var V1_3 = Upgrade{
+	Name: "v1.3",
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{tibcmttypes.StoreKey, mttypes.StoreKey},
+	},
+	Handler: func(ctx sdk.Context, box Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if err := migratetibc.CreateClient(ctx,
+			box.AppCodec,
+			"v1.3",
+			box.TIBCKeeper.ClientKeeper,
+		); err != nil {
+			return nil, err
+		}
+		return box.RunMigrations(ctx, fromVM)
+	},
+}
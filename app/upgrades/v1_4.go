@@ -0,0 +1,103 @@
+package upgrades
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+)
+
+// V1_4 adds the authz and interchain accounts modules
+//
+// version upgrade:
+//
+//	nft :    1 -> 2
+//	auth:    2 -> 3
+//	bank:    2 -> 3
+//	coinswap 3 -> 4
+//	feegrant 1 -> 2
+//	gov      2 -> 3
+//	staking  2 -> 3
+//	upgrade  2 -> 3
+//
+// added module:
+//
+//	authz
+//
+// ibc application:
+//
+//	27-interchain-accounts
+var V1_4 = Upgrade{
+	Name: "v1.4",
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{authzkeeper.StoreKey},
+	},
+	ExpectedVersions: module.VersionMap{
+		// the ica module is initialized by the handler, skipping its InitGenesis
+		icatypes.ModuleName: ica.AppModule{}.ConsensusVersion(),
+	},
+	Handler: func(ctx sdk.Context, box Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		icaModule := box.ModuleManager.Modules[icatypes.ModuleName].(ica.AppModule)
+		// create ICS27 Controller submodule params
+		controllerParams := icacontrollertypes.Params{}
+		// create ICS27 Host submodule params, the allow-list is frozen as
+		// released so that the upgrade replays deterministically
+		hostParams := icahosttypes.Params{
+			HostEnabled: true,
+			AllowMessages: []string{
+				"/cosmos.authz.v1beta1.MsgExec",
+				"/cosmos.authz.v1beta1.MsgGrant",
+				"/cosmos.authz.v1beta1.MsgRevoke",
+				"/cosmos.bank.v1beta1.MsgSend",
+				"/cosmos.bank.v1beta1.MsgMultiSend",
+				"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress",
+				"/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission",
+				"/cosmos.distribution.v1beta1.MsgFundCommunityPool",
+				"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+				"/cosmos.feegrant.v1beta1.MsgGrantAllowance",
+				"/cosmos.feegrant.v1beta1.MsgRevokeAllowance",
+				"/cosmos.gov.v1beta1.MsgVoteWeighted",
+				"/cosmos.gov.v1beta1.MsgSubmitProposal",
+				"/cosmos.gov.v1beta1.MsgDeposit",
+				"/cosmos.gov.v1beta1.MsgVote",
+				"/cosmos.gov.v1.MsgVoteWeighted",
+				"/cosmos.gov.v1.MsgSubmitProposal",
+				"/cosmos.gov.v1.MsgDeposit",
+				"/cosmos.gov.v1.MsgVote",
+				"/cosmos.staking.v1beta1.MsgEditValidator",
+				"/cosmos.staking.v1beta1.MsgDelegate",
+				"/cosmos.staking.v1beta1.MsgUndelegate",
+				"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+				"/cosmos.staking.v1beta1.MsgCreateValidator",
+				"/cosmos.vesting.v1beta1.MsgCreateVestingAccount",
+				"/ibc.applications.transfer.v1.MsgTransfer",
+
+				"/irismod.nft.MsgIssueDenom",
+				"/irismod.nft.MsgTransferDenom",
+				"/irismod.nft.MsgMintNFT",
+				"/irismod.nft.MsgEditNFT",
+				"/irismod.nft.MsgTransferNFT",
+				"/irismod.nft.MsgBurnNFT",
+
+				"/irismod.mt.MsgIssueDenom",
+				"/irismod.mt.MsgTransferDenom",
+				"/irismod.mt.MsgMintMT",
+				"/irismod.mt.MsgEditMT",
+				"/irismod.mt.MsgTransferMT",
+				"/irismod.mt.MsgBurnMT",
+			},
+		}
+
+		ctx.Logger().Info("start to init interchainaccount module...")
+		// initialize ICS27 module
+		icaModule.InitModule(ctx, controllerParams, hostParams)
+		ctx.Logger().Info("start to run module migrations...")
+		return box.RunMigrations(ctx, fromVM)
+	},
+}
@@ -0,0 +1,48 @@
+package upgrades
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	sdkupgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	migratehtlc "github.com/furynet/furyhub/migrate/htlc"
+	didtypes "github.com/furynet/furyhub/modules/did/types"
+	ratelimittypes "github.com/furynet/furyhub/modules/ratelimit/types"
+	txpolicytypes "github.com/furynet/furyhub/modules/txpolicy/types"
+)
+
+// V1_5 adds the txpolicy, ratelimit and did modules
+//
+// added modules:
+//
+//	txpolicy
+//	ratelimit
+//	did
+//
+// The modules missing from the version map of the store are initialized with
+// their default genesis by the module migrations, the did module indexing the
+// existing records by creator. The htlc migration runs again, migrating the
+// htlcs left in the old format if any, and stores its report. The TIBC
+// clients defined in the plan info are created.
+var V1_5 = Upgrade{
+	Name: "v1.5",
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{txpolicytypes.StoreKey, ratelimittypes.StoreKey, didtypes.StoreKey},
+	},
+	PlanInfoClients: true,
+	Handler: func(ctx sdk.Context, box Toolbox, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// store the report of the htlc migration, run again so that the
+		// v1.1 upgrade keeps the state it shipped with
+		if _, err := migratehtlc.Rerun(ctx, box.AppCodec, box.HTLCKeeper, box.BankKeeper, box.GetKey(htlctypes.StoreKey)); err != nil {
+			return nil, err
+		}
+		return box.RunMigrations(ctx, fromVM)
+	},
+	Checks: []Check{
+		SupplyUnchangedCheck(),
+		InvariantsCheck(),
+	},
+}
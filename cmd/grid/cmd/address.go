@@ -0,0 +1,317 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/furynet/furyhub/address"
+)
+
+const (
+	flagChainPrefix    = "chain-prefix"
+	flagKeyType        = "key-type"
+	flagLegacyPrefixes = "legacy-prefixes"
+)
+
+// knownChainPrefixes returns the chain prefixes of this chain and of the
+// legacy ones
+func knownChainPrefixes() []string {
+	return append([]string{address.Bech32ChainPrefix}, address.LegacyChainPrefixes...)
+}
+
+// convertAddressCmd re-encodes an address between the known prefixes and key types
+func convertAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-address [address]",
+		Short: "Convert an address or a public key between the known bech32 prefixes and key types",
+		Long: fmt.Sprintf(`Re-encode a bech32 address or public key, of this chain or of a legacy one, with the
+prefix of a chain prefix and a key type (%s, %s or %s), keeping its key type by default.
+
+The known chain prefixes are %s.`,
+			address.KeyTypeAcc, address.KeyTypeVal, address.KeyTypeCons, strings.Join(knownChainPrefixes(), ", ")),
+		Example: fmt.Sprintf(`$ %s debug convert-address faa1ljemm0yznz58qxxs8xyak7fashcfxf5lssn6jm
+$ %s debug convert-address %s1... --key-type %s --chain-prefix i`,
+			version.AppName, version.AppName, address.Bech32PrefixAccAddr, address.KeyTypeVal),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainPrefix, _ := cmd.Flags().GetString(flagChainPrefix)
+			if !isKnownChainPrefix(chainPrefix) {
+				return fmt.Errorf("unknown chain prefix %s, must be one of %s", chainPrefix, strings.Join(knownChainPrefixes(), ", "))
+			}
+			keyType, _ := cmd.Flags().GetString(flagKeyType)
+
+			converted, err := address.ConvertBech32(args[0], chainPrefix, keyType, knownChainPrefixes()...)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), converted)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagChainPrefix, address.Bech32ChainPrefix, "Chain prefix of the converted address")
+	cmd.Flags().String(flagKeyType, "", fmt.Sprintf("Key type of the converted address (%s|%s|%s), the one of the address if empty", address.KeyTypeAcc, address.KeyTypeVal, address.KeyTypeCons))
+	return cmd
+}
+
+func isKnownChainPrefix(chainPrefix string) bool {
+	for _, known := range knownChainPrefixes() {
+		if chainPrefix == known {
+			return true
+		}
+	}
+	return false
+}
+
+// legacyAddressFlags are the flags holding the addresses of accounts or
+// validators of this chain, which --legacy-prefixes converts
+var legacyAddressFlags = map[string]bool{
+	flags.FlagFrom:       true,
+	flags.FlagFeeGranter: true,
+	flags.FlagFeePayer:   true,
+	"account":            true,
+	"added-by":           true,
+	"address":            true,
+	"allowed-validators": true,
+	"creator":            true,
+	"delegator":          true,
+	"deny-validators":    true,
+	"depositor":          true,
+	"deputy":             true,
+	"granter":            true,
+	"grantee":            true,
+	"jail-allowed-addrs": true,
+	"multisig":           true,
+	"owner":              true,
+	"provider":           true,
+	"providers":          true,
+	"recipient":          true,
+	"relayers":           true,
+	"supers":             true,
+	"to":                 true,
+	"validator":          true,
+	"voter":              true,
+}
+
+// foreignAddressFlags are the flags which may hold addresses of other chains,
+// which --legacy-prefixes leaves as is
+var foreignAddressFlags = map[string]bool{
+	flags.FlagNote:            true,
+	"receiver-on-other-chain": true,
+	"sender-on-other-chain":   true,
+}
+
+// legacyAddressArgs are the positions of the arguments holding the addresses
+// of accounts or validators of this chain, which --legacy-prefixes converts,
+// by command path without the app name
+var legacyAddressArgs = map[string][]int{
+	"add-genesis-account":                                     {0},
+	"debug addr":                                              {0},
+	"genesis add-super":                                       {0},
+	"query account":                                           {0},
+	"query auth account":                                      {0},
+	"query authz grants":                                      {0, 1},
+	"query authz grants-by-granter":                           {0},
+	"query authz grants-by-grantee":                           {0},
+	"query bank balances":                                     {0},
+	"query distribution commission":                           {0},
+	"query distribution rewards":                              {0, 1},
+	"query distribution slashes":                              {0},
+	"query distribution validator-outstanding-rewards":        {0},
+	"query feegrant grant":                                    {0, 1},
+	"query feegrant grants-by-granter":                        {0},
+	"query feegrant grants-by-grantee":                        {0},
+	"query gov deposit":                                       {1},
+	"query gov vote":                                          {1},
+	"query guardian blocked-address":                          {0},
+	"query interchain-accounts controller interchain-account": {0},
+	"query mt balances":                                       {0},
+	"query nft owner":                                         {0},
+	"query service binding":                                   {1},
+	"query service fees":                                      {0},
+	"query service requests":                                  {1},
+	"query service withdraw-addr":                             {0},
+	"query staking delegation":                                {0, 1},
+	"query staking delegations":                               {0},
+	"query staking delegations-to":                            {0},
+	"query staking redelegation":                              {0, 1, 2},
+	"query staking redelegations":                             {0},
+	"query staking redelegations-from":                        {0},
+	"query staking unbonding-delegation":                      {0, 1},
+	"query staking unbonding-delegations":                     {0},
+	"query staking unbonding-delegations-from":                {0},
+	"query staking validator":                                 {0},
+	"query token tokens":                                      {0},
+	"tx authz grant":                                          {0},
+	"tx authz revoke":                                         {0},
+	"tx bank send":                                            {0, 1},
+	"tx distribution set-withdraw-addr":                       {0},
+	"tx distribution withdraw-rewards":                        {0},
+	"tx feegrant grant":                                       {0, 1},
+	"tx feegrant revoke":                                      {0, 1},
+	"tx gov submit-legacy-proposal relayer-register":          {1},
+	"tx htlcasset set-deputy":                                 {1},
+	"tx mt transfer":                                          {0, 1},
+	"tx mt transfer-denom":                                    {0, 1},
+	"tx nft transfer":                                         {0},
+	"tx nft transfer-denom":                                   {0},
+	"tx service disable":                                      {1},
+	"tx service enable":                                       {1},
+	"tx service refund-deposit":                               {1},
+	"tx service set-withdraw-addr":                            {0},
+	"tx service update-binding":                               {1},
+	"tx service withdraw-fees":                                {0},
+	"tx staking cancel-unbond":                                {0},
+	"tx staking delegate":                                     {0},
+	"tx staking redelegate":                                   {0, 1},
+	"tx staking unbond":                                       {0},
+	"tx vesting create-periodic-vesting-account":              {0},
+	"tx vesting create-permanent-locked-account":              {0},
+	"tx vesting create-vesting-account":                       {0},
+}
+
+// legacyAddressVarArgs are the commands taking any number of arguments which
+// all hold addresses of this chain, but the given number of last ones
+var legacyAddressVarArgs = map[string]int{
+	"keys show":          0,
+	"tx bank multi-send": 1,
+}
+
+// foreignAddressArgs are the positions of the arguments which may hold
+// addresses of other chains, which --legacy-prefixes leaves as is
+var foreignAddressArgs = map[string][]int{
+	"debug convert-address":         {0},
+	"keys parse":                    {0},
+	"tx ibc-transfer transfer":      {2},
+	"tx tibc-mt-transfer transfer":  {1},
+	"tx tibc-nft-transfer transfer": {1},
+}
+
+// convertLegacyAddresses converts the addresses having the legacy chain
+// prefixes accepted by --legacy-prefixes in the arguments and flags known to
+// hold addresses of this chain, warning of each conversion. The arguments and
+// flags known to hold addresses of other chains, such as the receivers of IBC
+// transfers or the notes, are left as is, while a legacy address in any other
+// argument or flag is rejected rather than sent unconverted.
+func convertLegacyAddresses(cmd *cobra.Command, args []string) error {
+	legacyPrefixes, _ := cmd.Flags().GetStringSlice(flagLegacyPrefixes)
+	if len(legacyPrefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range legacyPrefixes {
+		if prefix == address.Bech32ChainPrefix || !isKnownChainPrefix(prefix) {
+			return fmt.Errorf("unknown legacy chain prefix %s, must be one of %s", prefix, strings.Join(address.LegacyChainPrefixes, ", "))
+		}
+	}
+
+	convert := func(value string) string {
+		values := strings.Split(value, ",")
+		for i, v := range values {
+			if converted, ok := convertLegacyAddress(v, legacyPrefixes); ok {
+				fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: converted the legacy address %s to %s\n", v, converted)
+				values[i] = converted
+			}
+		}
+		return strings.Join(values, ",")
+	}
+	check := func(what, value string) error {
+		for _, v := range strings.Split(value, ",") {
+			if _, ok := convertLegacyAddress(v, legacyPrefixes); ok {
+				return fmt.Errorf(
+					"%s of %s holds the legacy address %s, which --legacy-prefixes does not convert there, convert it with %s debug convert-address",
+					what, cmd.CommandPath(), v, cmd.Root().Name(),
+				)
+			}
+		}
+		return nil
+	}
+
+	var path string
+	if p := strings.SplitN(cmd.CommandPath(), " ", 2); len(p) == 2 {
+		path = p[1]
+	}
+	addressArgs := make(map[int]bool)
+	for _, i := range legacyAddressArgs[path] {
+		addressArgs[i] = true
+	}
+	if last, ok := legacyAddressVarArgs[path]; ok {
+		for i := 0; i < len(args)-last; i++ {
+			addressArgs[i] = true
+		}
+	}
+	foreignArgs := make(map[int]bool)
+	for _, i := range foreignAddressArgs[path] {
+		foreignArgs[i] = true
+	}
+	for i := range args {
+		switch {
+		case addressArgs[i]:
+			args[i] = convert(args[i])
+		case !foreignArgs[i]:
+			if err := check(fmt.Sprintf("the argument %d", i+1), args[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	var err error
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if err != nil || foreignAddressFlags[flag.Name] {
+			return
+		}
+		var values []string
+		slice, isSlice := flag.Value.(pflag.SliceValue)
+		switch {
+		case isSlice:
+			values = slice.GetSlice()
+		case flag.Value.Type() == "string":
+			values = []string{flag.Value.String()}
+		default:
+			return
+		}
+
+		if !legacyAddressFlags[flag.Name] {
+			for _, value := range values {
+				if err = check("the flag --"+flag.Name, value); err != nil {
+					return
+				}
+			}
+			return
+		}
+		if isSlice {
+			for i := range values {
+				values[i] = convert(values[i])
+			}
+			err = slice.Replace(values)
+			return
+		}
+		if converted := convert(values[0]); converted != values[0] {
+			err = flag.Value.Set(converted)
+		}
+	})
+	return err
+}
+
+// convertLegacyAddress converts an address having one of the legacy chain
+// prefixes to the address of this chain, the public keys being left as is
+func convertLegacyAddress(addr string, legacyPrefixes []string) (string, bool) {
+	bech32Prefix, _, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return addr, false
+	}
+	_, _, pub, err := address.ParseBech32Prefix(bech32Prefix, legacyPrefixes...)
+	if err != nil || pub {
+		return addr, false
+	}
+	converted, err := address.ConvertBech32(addr, address.Bech32ChainPrefix, "", legacyPrefixes...)
+	if err != nil {
+		return addr, false
+	}
+	return converted, true
+}
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	legacyAddress    = "faa1ljemm0yznz58qxxs8xyak7fashcfxf5lssn6jm"
+	convertedAddress = "did:fury:aa1ljemm0yznz58qxxs8xyak7fashcfxf5lhxl2lr"
+)
+
+func TestConvertAddressCmd(t *testing.T) {
+	testCases := []struct {
+		args []string
+		out  string
+		err  string
+	}{
+		{[]string{legacyAddress}, convertedAddress, ""},
+		{[]string{convertedAddress, "--chain-prefix", "i"}, "iaa1ljemm0yznz58qxxs8xyak7fashcfxf5lgl4zjx", ""},
+		{[]string{legacyAddress, "--key-type", "val"}, "did:fury:va1ljemm0yznz58qxxs8xyak7fashcfxf5lzh49zy", ""},
+		{[]string{legacyAddress, "--key-type", "pub"}, "", "unknown key type pub"},
+		{[]string{legacyAddress, "--chain-prefix", "cosmos"}, "", "unknown chain prefix cosmos"},
+		{[]string{"cosmos1ljemm0yznz58qxxs8xyak7fashcfxf5laa4nsh"}, "", "unknown bech32 prefix cosmos"},
+	}
+	for _, tc := range testCases {
+		cmd := convertAddressCmd()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(tc.args)
+		err := cmd.Execute()
+		if tc.err != "" {
+			require.ErrorContains(t, err, tc.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.out+"\n", out.String())
+	}
+}
+
+func TestConvertLegacyAddresses(t *testing.T) {
+	newCmd := func(path string, legacyPrefixes ...string) (*cobra.Command, *bytes.Buffer) {
+		root := &cobra.Command{Use: "grid"}
+		root.PersistentFlags().StringSlice(flagLegacyPrefixes, nil, "")
+		cmd := root
+		for _, use := range strings.Fields(path) {
+			child := &cobra.Command{Use: use}
+			cmd.AddCommand(child)
+			cmd = child
+		}
+		cmd.Flags().String(flags.FlagFrom, "", "")
+		cmd.Flags().String(flags.FlagNote, "", "")
+		cmd.Flags().String("receiver-on-other-chain", "", "")
+		cmd.Flags().String("description", "", "")
+		cmd.Flags().StringSlice("grantee", nil, "")
+		cmd.Flags().Int("limit", 0, "")
+		// merge the persistent flags of the root as the execution does
+		cmd.InheritedFlags()
+		warnings := &bytes.Buffer{}
+		cmd.SetErr(warnings)
+		for _, prefix := range legacyPrefixes {
+			require.NoError(t, cmd.Flags().Set(flagLegacyPrefixes, prefix))
+		}
+		return cmd, warnings
+	}
+
+	cmd, warnings := newCmd("tx bank send", "f")
+	require.NoError(t, cmd.Flags().Set(flags.FlagFrom, legacyAddress))
+	require.NoError(t, cmd.Flags().Set(flags.FlagNote, legacyAddress))
+	require.NoError(t, cmd.Flags().Set("receiver-on-other-chain", legacyAddress))
+	require.NoError(t, cmd.Flags().Set("grantee", legacyAddress+","+convertedAddress))
+	require.NoError(t, cmd.Flags().Set("limit", "10"))
+	args := []string{legacyAddress, "iaa1ljemm0yznz58qxxs8xyak7fashcfxf5lgl4zjx", "1fury"}
+	require.NoError(t, convertLegacyAddresses(cmd, args))
+
+	// the addresses of the prefixes not listed are left as is
+	require.Equal(t, []string{convertedAddress, "iaa1ljemm0yznz58qxxs8xyak7fashcfxf5lgl4zjx", "1fury"}, args)
+	from, _ := cmd.Flags().GetString(flags.FlagFrom)
+	require.Equal(t, convertedAddress, from)
+	grantees, _ := cmd.Flags().GetStringSlice("grantee")
+	require.Equal(t, []string{convertedAddress, convertedAddress}, grantees)
+	require.Equal(t, 3, bytes.Count(warnings.Bytes(), []byte("WARNING: converted the legacy address")))
+
+	// the notes and the addresses of other chains are left as is
+	note, _ := cmd.Flags().GetString(flags.FlagNote)
+	require.Equal(t, legacyAddress, note)
+	receiver, _ := cmd.Flags().GetString("receiver-on-other-chain")
+	require.Equal(t, legacyAddress, receiver)
+
+	// so are the arguments of the commands not known to take addresses of this
+	// chain, such as the receiver of an IBC transfer
+	cmd, warnings = newCmd("tx ibc-transfer transfer", "f")
+	args = []string{"transfer", "channel-0", legacyAddress, "1fury"}
+	require.NoError(t, convertLegacyAddresses(cmd, args))
+	require.Equal(t, []string{"transfer", "channel-0", legacyAddress, "1fury"}, args)
+	require.Empty(t, warnings.String())
+
+	// a legacy address anywhere else is rejected rather than sent as is
+	cmd, _ = newCmd("tx bank multi-send", "f")
+	args = []string{legacyAddress, legacyAddress, legacyAddress, "1fury"}
+	require.NoError(t, convertLegacyAddresses(cmd, args))
+	require.Equal(t, []string{convertedAddress, convertedAddress, convertedAddress, "1fury"}, args)
+	args = []string{legacyAddress, legacyAddress}
+	require.ErrorContains(t, convertLegacyAddresses(cmd, args), "the argument 2 of grid tx bank multi-send holds the legacy address")
+
+	cmd, _ = newCmd("tx token transfer", "f")
+	require.ErrorContains(t, convertLegacyAddresses(cmd, []string{legacyAddress}), "the argument 1 of grid tx token transfer holds the legacy address")
+	require.NoError(t, cmd.Flags().Set("description", "1fury,"+legacyAddress))
+	require.ErrorContains(t, convertLegacyAddresses(cmd, []string{"fury"}), "the flag --description of grid tx token transfer holds the legacy address")
+
+	// the conversion is opt-in
+	cmd, warnings = newCmd("tx bank send")
+	args = []string{legacyAddress}
+	require.NoError(t, convertLegacyAddresses(cmd, args))
+	require.Equal(t, []string{legacyAddress}, args)
+	require.Empty(t, warnings.String())
+
+	cmd, _ = newCmd("tx bank send", "did:fury:")
+	require.ErrorContains(t, convertLegacyAddresses(cmd, nil), "unknown legacy chain prefix")
+}
+
+// addressArgNameRegexp matches the names of the arguments and flags holding
+// addresses of accounts or validators
+var addressArgNameRegexp = regexp.MustCompile(`addr|granter|grantee|payer|recipient|receiver|sender|owner|deputy|voter|deposit[eo]r|validator|delegator|provider|creator|relayer|super|multisig|account|added-by|^from$|^to$`)
+
+// TestLegacyAddressLists walks the command tree to check that every argument
+// or flag named after an address is known to --legacy-prefixes, either as an
+// address of this chain or of another one
+func TestLegacyAddressLists(t *testing.T) {
+	rootCmd, _ := NewRootCmd()
+	argRegexp := regexp.MustCompile(`[\[<]([^\]>]+)[\]>]`)
+	notAddressFlags := map[string]bool{
+		"grpc-addr":            true,
+		"priv_validator_laddr": true,
+		"starting-ip-address":  true,
+	}
+
+	commands := make(map[string]*cobra.Command)
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		path := strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+		commands[path] = cmd
+
+		cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+			if strings.Contains(flag.Name, ".") || notAddressFlags[flag.Name] || !addressArgNameRegexp.MatchString(flag.Name) {
+				return
+			}
+			if _, ok := flag.Value.(pflag.SliceValue); !ok && flag.Value.Type() != "string" {
+				return
+			}
+			require.True(t, legacyAddressFlags[flag.Name] || foreignAddressFlags[flag.Name], "flag --%s of %s", flag.Name, path)
+		})
+
+		if cmd.Runnable() {
+			// the flags shown in the usage are not arguments
+			use := strings.SplitN(cmd.Use, " --", 2)[0]
+			for i, arg := range argRegexp.FindAllStringSubmatch(use, -1) {
+				name := arg[1]
+				if !addressArgNameRegexp.MatchString(name) || strings.Contains(name, "pub") || strings.Contains(name, "num") {
+					continue
+				}
+				_, varArgs := legacyAddressVarArgs[path]
+				known := varArgs || containsInt(legacyAddressArgs[path], i) || containsInt(foreignAddressArgs[path], i)
+				require.True(t, known, "argument %d %s of %s", i, name, path)
+			}
+		}
+		for _, child := range cmd.Commands() {
+			walk(child)
+		}
+	}
+	walk(rootCmd)
+
+	// the lists hold no command which no longer exists
+	for _, list := range []map[string][]int{legacyAddressArgs, foreignAddressArgs} {
+		for path, positions := range list {
+			cmd, ok := commands[path]
+			require.True(t, ok, path)
+			args := argRegexp.FindAllString(strings.SplitN(cmd.Use, " --", 2)[0], -1)
+			for _, i := range positions {
+				require.Less(t, i, len(args), path)
+			}
+		}
+	}
+	for path := range legacyAddressVarArgs {
+		require.Contains(t, commands, path)
+	}
+}
+
+func containsInt(list []int, i int) bool {
+	for _, v := range list {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/furynet/furyhub/app"
+)
+
+const (
+	flagModules        = "modules"
+	flagOutputDocument = "output-document"
+	flagGzip           = "gzip"
+
+	appStateMarker = `"app_state":{}`
+)
+
+// exportCmd dumps the app state to JSON, streaming the genesis state of each
+// module to the output as soon as it is exported. It replaces the export
+// command of the sdk server.
+func exportCmd(ac appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export state to JSON",
+		Long: `Export the application state to a genesis document. The state of each module is
+written out as soon as it is exported, so only one module is held in memory at a
+time.
+
+Use --modules to export only some modules, --output-document to write the
+genesis document to a file instead of the standard output, or --output-dir to
+write the state of each module to its own <module>.json file along with a
+genesis.json holding everything but the app state. Add --gzip to compress the
+module files.`,
+		Example: fmt.Sprintf(`$ %s export --height 100000 --output-document genesis.json
+$ %s export --modules bank,token --output-dir ./export --gzip`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+			modules, _ := cmd.Flags().GetStringSlice(flagModules)
+			outputDocument, _ := cmd.Flags().GetString(flagOutputDocument)
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			compress, _ := cmd.Flags().GetBool(flagGzip)
+
+			if outputDocument != "" && outputDir != "" {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", flagOutputDocument, flagOutputDir)
+			}
+			if compress && outputDir == "" {
+				return fmt.Errorf("--%s requires --%s", flagGzip, flagOutputDir)
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			gridApp, err := ac.loadApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+			if _, err := gridApp.ExportModules(modules); err != nil {
+				return err
+			}
+
+			var writer genesisWriter
+			switch {
+			case outputDir != "":
+				if err := os.MkdirAll(outputDir, 0o755); err != nil {
+					return err
+				}
+				writer = newDirGenesisWriter(outputDir, compress)
+			case outputDocument != "":
+				file, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				if writer, err = newDocGenesisWriter(file, doc); err != nil {
+					return err
+				}
+			default:
+				if writer, err = newDocGenesisWriter(cmd.OutOrStdout(), doc); err != nil {
+					return err
+				}
+			}
+
+			exported, err := gridApp.StreamAppStateAndValidators(app.ExportOptions{
+				ForZeroHeight:    forZeroHeight,
+				JailAllowedAddrs: jailAllowedAddrs,
+				Modules:          modules,
+			}, writer.WriteModule)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+			return writer.Close(exportedGenesisDoc(doc, exported))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(server.FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(server.FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().StringSlice(flagModules, []string{}, "Comma-separated list of modules to export (all modules if empty)")
+	cmd.Flags().String(flagOutputDocument, "", "Write the genesis document to the given file instead of the standard output")
+	cmd.Flags().String(flagOutputDir, "", "Write the state of each module to its own file in the given directory")
+	cmd.Flags().Bool(flagGzip, false, "Compress the module files written to --output-dir with gzip")
+
+	return cmd
+}
+
+// exportedGenesisDoc returns the genesis document of the exported app, without
+// its app state, in the same way as the export command of the sdk server. The
+// consensus params the chain has not stored yet are left unchanged.
+func exportedGenesisDoc(doc *tmtypes.GenesisDoc, exported servertypes.ExportedApp) *tmtypes.GenesisDoc {
+	doc.AppState = nil
+	doc.Validators = exported.Validators
+	doc.InitialHeight = exported.Height
+
+	params := exported.ConsensusParams
+	if params == nil {
+		return doc
+	}
+	if params.Block != nil {
+		doc.ConsensusParams.Block.MaxBytes = params.Block.MaxBytes
+		doc.ConsensusParams.Block.MaxGas = params.Block.MaxGas
+	}
+	if params.Evidence != nil {
+		doc.ConsensusParams.Evidence = tmproto.EvidenceParams{
+			MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  params.Evidence.MaxAgeDuration,
+			MaxBytes:        params.Evidence.MaxBytes,
+		}
+	}
+	if params.Validator != nil {
+		doc.ConsensusParams.Validator = tmproto.ValidatorParams{
+			PubKeyTypes: params.Validator.PubKeyTypes,
+		}
+	}
+	return doc
+}
+
+// encodeGenesisDoc encodes the genesis document with an empty app state and
+// sorted keys, split around the app state
+func encodeGenesisDoc(doc *tmtypes.GenesisDoc) (prefix, suffix []byte, err error) {
+	emptied := *doc
+	emptied.AppState = json.RawMessage("{}")
+
+	// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
+	// (except for stuff inside AppState).
+	encoded, err := tmjson.Marshal(&emptied)
+	if err != nil {
+		return nil, nil, err
+	}
+	encoded, err = sdk.SortJSON(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := bytes.Index(encoded, []byte(appStateMarker))
+	if i < 0 {
+		return nil, nil, errors.New("app state not found in the genesis document")
+	}
+	return encoded[:i+len(appStateMarker)-1], encoded[i+len(appStateMarker)-1:], nil
+}
+
+// genesisWriter writes the exported genesis state module by module
+type genesisWriter interface {
+	// WriteModule writes the genesis state of a module
+	WriteModule(moduleName string, state json.RawMessage) error
+	// Close writes what remains of the genesis document
+	Close(doc *tmtypes.GenesisDoc) error
+}
+
+// docGenesisWriter streams a single genesis document. The genesis document
+// keys are sorted and app_state only follows app_hash, which the export does
+// not change, so the head of the document is known ahead of the modules.
+type docGenesisWriter struct {
+	w       *bufio.Writer
+	prefix  []byte
+	modules int
+}
+
+func newDocGenesisWriter(w io.Writer, doc *tmtypes.GenesisDoc) (*docGenesisWriter, error) {
+	prefix, _, err := encodeGenesisDoc(&tmtypes.GenesisDoc{AppHash: doc.AppHash})
+	if err != nil {
+		return nil, err
+	}
+	return &docGenesisWriter{w: bufio.NewWriter(w), prefix: prefix}, nil
+}
+
+// WriteModule implements genesisWriter
+func (dw *docGenesisWriter) WriteModule(moduleName string, state json.RawMessage) error {
+	sorted, err := sdk.SortJSON(state)
+	if err != nil {
+		return err
+	}
+	name, err := json.Marshal(moduleName)
+	if err != nil {
+		return err
+	}
+
+	separator := []byte{','}
+	if dw.modules == 0 {
+		separator = dw.prefix
+	}
+	dw.modules++
+
+	for _, b := range [][]byte{separator, name, {':'}, sorted} {
+		if _, err := dw.w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close implements genesisWriter
+func (dw *docGenesisWriter) Close(doc *tmtypes.GenesisDoc) error {
+	prefix, suffix, err := encodeGenesisDoc(doc)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(prefix, dw.prefix) {
+		return errors.New("genesis document head changed during the export")
+	}
+
+	if dw.modules == 0 {
+		if _, err := dw.w.Write(prefix); err != nil {
+			return err
+		}
+	}
+	if _, err := dw.w.Write(suffix); err != nil {
+		return err
+	}
+	if err := dw.w.WriteByte('\n'); err != nil {
+		return err
+	}
+	return dw.w.Flush()
+}
+
+// dirGenesisWriter writes the genesis state of each module to its own file
+type dirGenesisWriter struct {
+	dir      string
+	compress bool
+}
+
+func newDirGenesisWriter(dir string, compress bool) *dirGenesisWriter {
+	return &dirGenesisWriter{dir: dir, compress: compress}
+}
+
+// ModuleFile returns the path of the file holding the genesis state of a module
+func (dw *dirGenesisWriter) ModuleFile(moduleName string) string {
+	name := moduleName + ".json"
+	if dw.compress {
+		name += ".gz"
+	}
+	return filepath.Join(dw.dir, name)
+}
+
+// WriteModule implements genesisWriter
+func (dw *dirGenesisWriter) WriteModule(moduleName string, state json.RawMessage) (err error) {
+	file, err := os.Create(dw.ModuleFile(moduleName))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	if !dw.compress {
+		_, err = file.Write(state)
+		return err
+	}
+
+	zw := gzip.NewWriter(file)
+	if _, err := zw.Write(state); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// Close implements genesisWriter, writing the genesis document with an empty
+// app state to genesis.json
+func (dw *dirGenesisWriter) Close(doc *tmtypes.GenesisDoc) error {
+	prefix, suffix, err := encodeGenesisDoc(doc)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dw.dir, "genesis.json"), append(append(prefix, suffix...), '\n'), 0o644)
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func testGenesisDoc() *tmtypes.GenesisDoc {
+	return &tmtypes.GenesisDoc{
+		GenesisTime:     time.Unix(1600000000, 0).UTC(),
+		ChainID:         "gridiron-test",
+		InitialHeight:   42,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppHash:         []byte{0xab, 0xcd},
+	}
+}
+
+func TestDocGenesisWriter(t *testing.T) {
+	modules := map[string]json.RawMessage{
+		"bank":     json.RawMessage(`{"supply":[],"balances":[{"address":"a"}]}`),
+		"guardian": json.RawMessage(`{"supers":[]}`),
+	}
+
+	var buf bytes.Buffer
+	writer, err := newDocGenesisWriter(&buf, testGenesisDoc())
+	require.NoError(t, err)
+	for _, name := range []string{"bank", "guardian"} {
+		require.NoError(t, writer.WriteModule(name, modules[name]))
+	}
+	require.NoError(t, writer.Close(testGenesisDoc()))
+
+	// same output as marshalling the whole document at once
+	doc := testGenesisDoc()
+	appState, err := json.Marshal(modules)
+	require.NoError(t, err)
+	doc.AppState = appState
+	encoded, err := tmjson.Marshal(doc)
+	require.NoError(t, err)
+	require.Equal(t, string(sdk.MustSortJSON(encoded))+"\n", buf.String())
+
+	_, err = tmtypes.GenesisDocFromJSON(buf.Bytes())
+	require.NoError(t, err)
+
+	// no module
+	buf.Reset()
+	writer, err = newDocGenesisWriter(&buf, testGenesisDoc())
+	require.NoError(t, err)
+	require.NoError(t, writer.Close(testGenesisDoc()))
+	exported, err := tmtypes.GenesisDocFromJSON(buf.Bytes())
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(exported.AppState))
+
+	// the head of the document must not change
+	changed := testGenesisDoc()
+	changed.AppHash = nil
+	writer, err = newDocGenesisWriter(io.Discard, testGenesisDoc())
+	require.NoError(t, err)
+	require.Error(t, writer.Close(changed))
+}
+
+func TestDirGenesisWriter(t *testing.T) {
+	state := json.RawMessage(`{"supers":[]}`)
+
+	for _, compress := range []bool{false, true} {
+		dir := t.TempDir()
+		writer := newDirGenesisWriter(dir, compress)
+		require.NoError(t, writer.WriteModule("guardian", state))
+		require.NoError(t, writer.Close(testGenesisDoc()))
+
+		file, err := os.Open(filepath.Join(dir, "guardian.json"))
+		if compress {
+			require.True(t, os.IsNotExist(err))
+			file, err = os.Open(filepath.Join(dir, "guardian.json.gz"))
+		}
+		require.NoError(t, err)
+
+		var r io.Reader = file
+		if compress {
+			r, err = gzip.NewReader(file)
+			require.NoError(t, err)
+		}
+		bz, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+		require.Equal(t, string(state), string(bz))
+
+		doc, err := tmtypes.GenesisDocFromFile(filepath.Join(dir, "genesis.json"))
+		require.NoError(t, err)
+		require.Equal(t, "gridiron-test", doc.ChainID)
+		require.JSONEq(t, `{}`, string(doc.AppState))
+	}
+}
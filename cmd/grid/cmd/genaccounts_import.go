@@ -0,0 +1,595 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/furynet/furyhub/address"
+)
+
+const (
+	flagFormat          = "format"
+	flagMergeDuplicates = "merge-duplicates"
+	flagExpectedSupply  = "expected-supply"
+
+	formatCSV = "csv"
+
+	vestingTypeNone       = "none"
+	vestingTypeContinuous = "continuous"
+	vestingTypeDelayed    = "delayed"
+	vestingTypePeriodic   = "periodic"
+)
+
+// genesisAccountColumns are the columns of an accounts CSV file, which are also
+// the fields of the entries of an accounts JSON file
+var genesisAccountColumns = []string{
+	"address", "coins", "vesting_type", "vesting_amount", "vesting_start_time", "vesting_end_time", "vesting_periods",
+}
+
+// genesisAccountEntry is an entry of an accounts file
+type genesisAccountEntry struct {
+	Address          string          `json:"address"`
+	Coins            string          `json:"coins"`
+	VestingType      string          `json:"vesting_type,omitempty"`
+	VestingAmount    string          `json:"vesting_amount,omitempty"`
+	VestingStartTime vestingTime     `json:"vesting_start_time,omitempty"`
+	VestingEndTime   vestingTime     `json:"vesting_end_time,omitempty"`
+	VestingPeriods   []vestingPeriod `json:"vesting_periods,omitempty"`
+
+	// pos locates the entry in the file for the errors
+	pos string
+}
+
+// vestingPeriod is a period of a periodic vesting schedule
+type vestingPeriod struct {
+	LengthSeconds int64  `json:"length_seconds"`
+	Coins         string `json:"coins"`
+}
+
+// vestingTime is a unix time, given as a number or as a unix or RFC 3339 time string
+type vestingTime int64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *vestingTime) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		var unix int64
+		if err := json.Unmarshal(bz, &unix); err != nil {
+			return fmt.Errorf("invalid time %s, must be a unix time or an RFC 3339 time", bz)
+		}
+		*t = vestingTime(unix)
+		return nil
+	}
+	parsed, err := parseVestingTime(s)
+	*t = parsed
+	return err
+}
+
+// parseVestingTime parses a unix or RFC 3339 time, an empty string being the zero time
+func parseVestingTime(s string) (vestingTime, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if unix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return vestingTime(unix), nil
+	}
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, must be a unix time or an RFC 3339 time", s)
+	}
+	return vestingTime(parsed.Unix()), nil
+}
+
+// parseVestingPeriods parses the periods of a CSV file, separated by
+// semicolons, each period being its length in seconds and its coins separated
+// by a colon
+func parseVestingPeriods(s string) ([]vestingPeriod, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var periods []vestingPeriod
+	for _, period := range strings.Split(s, ";") {
+		length, coins, ok := strings.Cut(strings.TrimSpace(period), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid vesting period %s, must be <length_seconds>:<coins>", period)
+		}
+		lengthSeconds, err := strconv.ParseInt(length, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vesting period length %s: %w", length, err)
+		}
+		periods = append(periods, vestingPeriod{LengthSeconds: lengthSeconds, Coins: coins})
+	}
+	return periods, nil
+}
+
+// AddGenesisAccountsCmd returns add-genesis-accounts cobra Command.
+func AddGenesisAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts [file]",
+		Short: "Add the genesis accounts of a CSV or JSON file to genesis.json",
+		Long: fmt.Sprintf(`Add the genesis accounts of a CSV or JSON file to genesis.json, which is written once.
+
+A CSV file starts with a header naming its columns, among %s.
+A JSON file is an array of objects having these fields, the periods being objects
+{"length_seconds": <seconds>, "coins": "<coins>"}; in a CSV file they are separated by semicolons,
+each period being <length_seconds>:<coins>. The times are unix or RFC 3339 times.
+
+The address may be a key name, looked up in the local Keybase. The vesting type is one of
+%s, %s, %s or %s, and is derived from the vesting parameters if empty, as add-genesis-account does.
+The vesting amount defaults to the coins of the account, the vesting amount of a periodic
+vesting account being the total of its periods, which start at the vesting start time.
+
+The entries repeating another one are skipped, --merge-duplicates adds up the coins of the
+accounts without vesting listed more than once, the other duplicate addresses being rejected.
+The invalid entries are all reported and nothing is written if any. The supply is not changed:
+the total of the balances with the imported coins must be the bank supply, when set in
+genesis.json, and the --expected-supply, when given, or nothing is written.`,
+			strings.Join(genesisAccountColumns, ", "), vestingTypeNone, vestingTypeContinuous, vestingTypeDelayed, vestingTypePeriodic),
+		Example: fmt.Sprintf(`$ cat accounts.csv
+address,coins,vesting_type,vesting_start_time,vesting_periods
+%[1]s1...,1000000ufury,,,
+%[1]s1...,3000000ufury,periodic,2023-01-01T00:00:00Z,"2592000:1000000ufury;2592000:1000000ufury;2592000:1000000ufury"
+$ %[2]s add-genesis-accounts accounts.csv`, address.Bech32PrefixAccAddr, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			expectedSupplyStr, _ := cmd.Flags().GetString(flagExpectedSupply)
+			expectedSupply, err := sdk.ParseCoinsNormalized(expectedSupplyStr)
+			if err != nil {
+				return fmt.Errorf("invalid expected supply %s: %w", expectedSupplyStr, err)
+			}
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			entries, err := readGenesisAccountEntries(args[0], format)
+			if err != nil {
+				return err
+			}
+
+			// the errors of the entries are not a misuse of the command
+			cmd.SilenceUsage = true
+
+			mergeDuplicates, _ := cmd.Flags().GetBool(flagMergeDuplicates)
+			genAccounts, balances, errs := buildGenesisAccounts(
+				entries, genesisAccountAddress(cmd, clientCtx), mergeDuplicates, cmd.ErrOrStderr(),
+			)
+			if len(errs) > 0 {
+				for _, err := range errs {
+					fmt.Fprintln(cmd.ErrOrStderr(), err)
+				}
+				return fmt.Errorf("%d of %d entries are invalid", len(errs), len(entries))
+			}
+
+			if err := addGenesisAccounts(clientCtx.Codec, config.GenesisFile(), genAccounts, balances, expectedSupply); err != nil {
+				return err
+			}
+
+			vesting := 0
+			total := sdk.NewCoins()
+			for i, acc := range genAccounts {
+				if _, ok := acc.(*authtypes.BaseAccount); !ok {
+					vesting++
+				}
+				total = total.Add(balances[i].Coins...)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "added %d genesis accounts, %d vesting, holding %s\n", len(genAccounts), vesting, total)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagFormat, "", "Format of the file (csv|json), given by its extension if empty")
+	cmd.Flags().Bool(flagMergeDuplicates, false, "Add up the coins of the accounts without vesting listed more than once")
+	cmd.Flags().String(flagExpectedSupply, "", "Total of the genesis balances expected with the imported coins")
+
+	return cmd
+}
+
+// genesisAccountAddress returns a function looking up the address of an
+// address or of a key name, the keyring being opened on the first key name
+func genesisAccountAddress(cmd *cobra.Command, clientCtx client.Context) func(string) (sdk.AccAddress, error) {
+	kr := clientCtx.Keyring
+	return func(addrOrName string) (sdk.AccAddress, error) {
+		if addr, err := sdk.AccAddressFromBech32(addrOrName); err == nil {
+			return addr, nil
+		}
+
+		if kr == nil {
+			keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			if err != nil {
+				return nil, err
+			}
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			kr, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, inBuf, clientCtx.Codec)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		info, err := kr.Key(addrOrName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get address from Keybase: %w", err)
+		}
+		return info.GetAddress()
+	}
+}
+
+// addGenesisAccounts adds the accounts and their balances to the genesis file,
+// which is written once. The supply is left as is: the total of the balances
+// must be the bank supply, if set, and the expected supply, if not empty.
+func addGenesisAccounts(cdc codec.Codec, genFile string, genAccounts authtypes.GenesisAccounts, balances []banktypes.Balance, expectedSupply sdk.Coins) error {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	existing := make(map[string]bool, len(accs)+len(bankGenState.Balances))
+	for _, acc := range accs {
+		existing[acc.GetAddress().String()] = true
+	}
+	for _, balance := range bankGenState.Balances {
+		existing[balance.Address] = true
+	}
+	for _, acc := range genAccounts {
+		if existing[acc.GetAddress().String()] {
+			return fmt.Errorf("cannot add account at existing address %s", acc.GetAddress())
+		}
+	}
+
+	// Add the new accounts to the set of genesis accounts and sanitize the
+	// accounts afterwards.
+	accs = append(accs, genAccounts...)
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState.Balances = append(bankGenState.Balances, balances...)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	total := sdk.NewCoins()
+	for _, balance := range bankGenState.Balances {
+		total = total.Add(balance.Coins...)
+	}
+	if !bankGenState.Supply.Empty() && !(total.DenomsSubsetOf(bankGenState.Supply) && total.IsEqual(bankGenState.Supply)) {
+		return fmt.Errorf("the balances would total %s, not the genesis supply %s", total, bankGenState.Supply)
+	}
+	if !expectedSupply.Empty() && !(total.DenomsSubsetOf(expectedSupply) && total.IsEqual(expectedSupply)) {
+		return fmt.Errorf("the balances would total %s, not the expected supply %s", total, expectedSupply)
+	}
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+// readGenesisAccountEntries reads the entries of an accounts file
+func readGenesisAccountEntries(path, format string) ([]genesisAccountEntry, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch format {
+	case formatCSV:
+		return readGenesisAccountCSV(f)
+	case formatJSON:
+		return readGenesisAccountJSON(f)
+	default:
+		return nil, fmt.Errorf("unknown format of %s, must be %s or %s", path, formatCSV, formatJSON)
+	}
+}
+
+func readGenesisAccountCSV(r io.Reader) ([]genesisAccountEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !isGenesisAccountColumn(name) {
+			return nil, fmt.Errorf("unknown column %s, must be one of %s", name, strings.Join(genesisAccountColumns, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicate column %s", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"address", "coins"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %s", name)
+		}
+	}
+
+	var entries []genesisAccountEntry
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		column := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		entry := genesisAccountEntry{
+			Address:       column("address"),
+			Coins:         column("coins"),
+			VestingType:   column("vesting_type"),
+			VestingAmount: column("vesting_amount"),
+			pos:           fmt.Sprintf("line %d", line),
+		}
+		if entry.VestingStartTime, err = parseVestingTime(column("vesting_start_time")); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.pos, err)
+		}
+		if entry.VestingEndTime, err = parseVestingTime(column("vesting_end_time")); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.pos, err)
+		}
+		if entry.VestingPeriods, err = parseVestingPeriods(column("vesting_periods")); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.pos, err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func readGenesisAccountJSON(r io.Reader) ([]genesisAccountEntry, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var entries []genesisAccountEntry
+	if err := decoder.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to decode the JSON accounts: %w", err)
+	}
+	for i := range entries {
+		entries[i].pos = fmt.Sprintf("entry %d", i+1)
+	}
+	return entries, nil
+}
+
+func isGenesisAccountColumn(name string) bool {
+	for _, column := range genesisAccountColumns {
+		if name == column {
+			return true
+		}
+	}
+	return false
+}
+
+// buildGenesisAccounts returns the accounts and the balances of the entries,
+// skipping the repeated entries with a warning, and the errors of the invalid
+// entries
+func buildGenesisAccounts(
+	entries []genesisAccountEntry,
+	lookupAddress func(string) (sdk.AccAddress, error),
+	mergeDuplicates bool,
+	warnings io.Writer,
+) (authtypes.GenesisAccounts, []banktypes.Balance, []error) {
+	var (
+		genAccounts authtypes.GenesisAccounts
+		balances    []banktypes.Balance
+		errs        []error
+	)
+	// index and position of the entry of each address
+	seen := make(map[string]int, len(entries))
+	seenPos := make(map[string]string, len(entries))
+
+	for _, entry := range entries {
+		addr, err := lookupAddress(entry.Address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.pos, err))
+			continue
+		}
+		genAccount, balance, err := entry.genesisAccount(addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.pos, err))
+			continue
+		}
+
+		i, ok := seen[balance.Address]
+		if !ok {
+			seen[balance.Address] = len(genAccounts)
+			seenPos[balance.Address] = entry.pos
+			genAccounts = append(genAccounts, genAccount)
+			balances = append(balances, balance)
+			continue
+		}
+
+		_, liquid := genAccount.(*authtypes.BaseAccount)
+		_, prevLiquid := genAccounts[i].(*authtypes.BaseAccount)
+		switch {
+		case genAccount.String() == genAccounts[i].String() && balance.Coins.IsEqual(balances[i].Coins):
+			fmt.Fprintf(warnings, "WARNING: %s: skipped the entry repeating the %s one\n", entry.pos, seenPos[balance.Address])
+
+		case mergeDuplicates && liquid && prevLiquid:
+			balances[i].Coins = balances[i].Coins.Add(balance.Coins...)
+			fmt.Fprintf(warnings, "WARNING: %s: merged the coins of %s into the %s entry\n", entry.pos, balance.Address, seenPos[balance.Address])
+
+		default:
+			errs = append(errs, fmt.Errorf("%s: duplicate address %s of the %s entry", entry.pos, balance.Address, seenPos[balance.Address]))
+		}
+	}
+	return genAccounts, balances, errs
+}
+
+// genesisAccount returns the account and the balance of the entry
+func (e genesisAccountEntry) genesisAccount(addr sdk.AccAddress) (authtypes.GenesisAccount, banktypes.Balance, error) {
+	coins, err := sdk.ParseCoinsNormalized(e.Coins)
+	if err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to parse coins: %w", err)
+	}
+	if coins.IsZero() {
+		return nil, banktypes.Balance{}, errors.New("no coins")
+	}
+	vestingAmt, err := sdk.ParseCoinsNormalized(e.VestingAmount)
+	if err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+
+	var periods authvesting.Periods
+	for _, period := range e.VestingPeriods {
+		periodCoins, err := sdk.ParseCoinsNormalized(period.Coins)
+		if err != nil {
+			return nil, banktypes.Balance{}, fmt.Errorf("failed to parse vesting period coins: %w", err)
+		}
+		if period.LengthSeconds <= 0 || periodCoins.IsZero() {
+			return nil, banktypes.Balance{}, errors.New("invalid vesting period, its length and coins must be positive")
+		}
+		periods = append(periods, authvesting.Period{Length: period.LengthSeconds, Amount: periodCoins})
+	}
+
+	start, end := int64(e.VestingStartTime), int64(e.VestingEndTime)
+	vestingType := e.VestingType
+	if vestingType == "" {
+		switch {
+		case len(periods) > 0:
+			vestingType = vestingTypePeriodic
+		case start != 0 && end != 0:
+			vestingType = vestingTypeContinuous
+		case end != 0:
+			vestingType = vestingTypeDelayed
+		default:
+			vestingType = vestingTypeNone
+		}
+	}
+	switch vestingType {
+	case vestingTypeNone, vestingTypeContinuous, vestingTypeDelayed, vestingTypePeriodic:
+	default:
+		return nil, banktypes.Balance{}, fmt.Errorf("unknown vesting type %s, must be one of %s, %s, %s or %s",
+			vestingType, vestingTypeNone, vestingTypeContinuous, vestingTypeDelayed, vestingTypePeriodic)
+	}
+	if vestingType != vestingTypePeriodic && len(periods) > 0 {
+		return nil, banktypes.Balance{}, fmt.Errorf("vesting periods given to a %s vesting account", vestingType)
+	}
+
+	balance := banktypes.Balance{Address: addr.String(), Coins: coins}
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	if vestingType == vestingTypeNone {
+		if !vestingAmt.IsZero() || start != 0 || end != 0 {
+			return nil, banktypes.Balance{}, errors.New("vesting parameters given to an account without vesting")
+		}
+		return baseAccount, balance, nil
+	}
+
+	if vestingType == vestingTypePeriodic {
+		if start == 0 || len(periods) == 0 {
+			return nil, banktypes.Balance{}, errors.New("invalid periodic vesting parameters; must supply start time and periods")
+		}
+		periodsEnd := start + periods.TotalLength()
+		if end != 0 && end != periodsEnd {
+			return nil, banktypes.Balance{}, fmt.Errorf("vesting end time %d is not the end of the periods %d", end, periodsEnd)
+		}
+		end = periodsEnd
+
+		periodsAmt := periods.TotalAmount()
+		if !vestingAmt.IsZero() && !vestingAmt.IsEqual(periodsAmt) {
+			return nil, banktypes.Balance{}, fmt.Errorf("vesting amount %s is not the total of the periods %s", vestingAmt, periodsAmt)
+		}
+		vestingAmt = periodsAmt
+	} else if vestingAmt.IsZero() {
+		vestingAmt = coins
+	}
+
+	if vestingAmt.IsAnyGT(coins) {
+		return nil, banktypes.Balance{}, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt, end)
+	var genAccount authtypes.GenesisAccount
+	switch vestingType {
+	case vestingTypeContinuous:
+		if start == 0 || end == 0 {
+			return nil, banktypes.Balance{}, errors.New("invalid continuous vesting parameters; must supply start and end time")
+		}
+		genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, start)
+
+	case vestingTypeDelayed:
+		if start != 0 || end == 0 {
+			return nil, banktypes.Balance{}, errors.New("invalid delayed vesting parameters; must supply end time only")
+		}
+		genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+	default:
+		genAccount = authvesting.NewPeriodicVestingAccountRaw(baseVestingAccount, start, periods)
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+	return genAccount, balance, nil
+}
@@ -0,0 +1,303 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+func testGenesisAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+func TestReadGenesisAccountEntries(t *testing.T) {
+	alice, bob := testGenesisAddress("alice"), testGenesisAddress("bob")
+	dir := t.TempDir()
+
+	csvFile := filepath.Join(dir, "accounts.csv")
+	require.NoError(t, os.WriteFile(csvFile, []byte(fmt.Sprintf(`address, coins, vesting_start_time, vesting_periods
+# investors
+%s, "10ufury,5uiris", ,
+%s, 20ufury, 2023-01-01T00:00:00Z, "100:10ufury; 200:10ufury"
+`, alice, bob)), 0o600))
+
+	jsonFile := filepath.Join(dir, "accounts.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(fmt.Sprintf(`[
+  {"address": "%s", "coins": "10ufury,5uiris"},
+  {"address": "%s", "coins": "20ufury", "vesting_start_time": 1672531200,
+   "vesting_periods": [{"length_seconds": 100, "coins": "10ufury"}, {"length_seconds": 200, "coins": "10ufury"}]}
+]`, alice, bob)), 0o600))
+
+	expected := []genesisAccountEntry{
+		{Address: alice.String(), Coins: "10ufury,5uiris"},
+		{
+			Address:          bob.String(),
+			Coins:            "20ufury",
+			VestingStartTime: 1672531200,
+			VestingPeriods:   []vestingPeriod{{LengthSeconds: 100, Coins: "10ufury"}, {LengthSeconds: 200, Coins: "10ufury"}},
+		},
+	}
+
+	for file, positions := range map[string][]string{
+		csvFile:  {"line 3", "line 4"},
+		jsonFile: {"entry 1", "entry 2"},
+	} {
+		entries, err := readGenesisAccountEntries(file, "")
+		require.NoError(t, err, file)
+		require.Len(t, entries, len(expected), file)
+		for i := range entries {
+			require.Equal(t, positions[i], entries[i].pos, file)
+			entries[i].pos = ""
+		}
+		require.Equal(t, expected, entries, file)
+	}
+
+	// the format is given by the extension or the flag
+	_, err := readGenesisAccountEntries(csvFile, formatJSON)
+	require.Error(t, err)
+
+	for name, content := range map[string]string{
+		"unknown column":  "address,coins,amount\n",
+		"missing column":  "address\n",
+		"invalid time":    "address,coins,vesting_end_time\na,1ufury,tomorrow\n",
+		"invalid periods": "address,coins,vesting_periods\na,1ufury,100\n",
+	} {
+		file := filepath.Join(dir, "invalid.csv")
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+		_, err := readGenesisAccountEntries(file, "")
+		require.Error(t, err, name)
+	}
+
+	txtFile := filepath.Join(dir, "accounts.txt")
+	require.NoError(t, os.WriteFile(txtFile, nil, 0o600))
+	_, err = readGenesisAccountEntries(txtFile, "")
+	require.Error(t, err)
+}
+
+func TestGenesisAccountEntry(t *testing.T) {
+	addr := testGenesisAddress("alice")
+	periods := []vestingPeriod{{LengthSeconds: 100, Coins: "10ufury"}, {LengthSeconds: 200, Coins: "20ufury"}}
+
+	testCases := []struct {
+		name    string
+		entry   genesisAccountEntry
+		expPass bool
+		check   func(t *testing.T, acc authtypes.GenesisAccount)
+	}{
+		{
+			name:    "base account",
+			entry:   genesisAccountEntry{Coins: "10ufury"},
+			expPass: true,
+			check: func(t *testing.T, acc authtypes.GenesisAccount) {
+				require.IsType(t, &authtypes.BaseAccount{}, acc)
+			},
+		},
+		{
+			name:    "continuous vesting of all coins",
+			entry:   genesisAccountEntry{Coins: "10ufury", VestingStartTime: 100, VestingEndTime: 200},
+			expPass: true,
+			check: func(t *testing.T, acc authtypes.GenesisAccount) {
+				vesting := acc.(*authvesting.ContinuousVestingAccount)
+				require.Equal(t, int64(100), vesting.StartTime)
+				require.Equal(t, "10ufury", vesting.OriginalVesting.String())
+			},
+		},
+		{
+			name:    "delayed vesting",
+			entry:   genesisAccountEntry{Coins: "10ufury", VestingType: vestingTypeDelayed, VestingAmount: "5ufury", VestingEndTime: 200},
+			expPass: true,
+			check: func(t *testing.T, acc authtypes.GenesisAccount) {
+				vesting := acc.(*authvesting.DelayedVestingAccount)
+				require.Equal(t, int64(200), vesting.EndTime)
+				require.Equal(t, "5ufury", vesting.OriginalVesting.String())
+			},
+		},
+		{
+			name:    "periodic vesting",
+			entry:   genesisAccountEntry{Coins: "40ufury", VestingStartTime: 100, VestingPeriods: periods},
+			expPass: true,
+			check: func(t *testing.T, acc authtypes.GenesisAccount) {
+				vesting := acc.(*authvesting.PeriodicVestingAccount)
+				require.Equal(t, int64(100), vesting.StartTime)
+				require.Equal(t, int64(400), vesting.EndTime)
+				require.Equal(t, "30ufury", vesting.OriginalVesting.String())
+				require.Len(t, vesting.VestingPeriods, 2)
+			},
+		},
+		{
+			name:    "periodic vesting with its end time",
+			entry:   genesisAccountEntry{Coins: "30ufury", VestingType: vestingTypePeriodic, VestingAmount: "30ufury", VestingStartTime: 100, VestingEndTime: 400, VestingPeriods: periods},
+			expPass: true,
+		},
+		{name: "no coins", entry: genesisAccountEntry{}},
+		{name: "invalid coins", entry: genesisAccountEntry{Coins: "10"}},
+		{name: "unknown vesting type", entry: genesisAccountEntry{Coins: "10ufury", VestingType: "linear", VestingEndTime: 200}},
+		{name: "vesting amount greater than coins", entry: genesisAccountEntry{Coins: "10ufury", VestingAmount: "20ufury", VestingEndTime: 200}},
+		{name: "vesting amount without schedule", entry: genesisAccountEntry{Coins: "10ufury", VestingAmount: "5ufury"}},
+		{name: "continuous vesting without start", entry: genesisAccountEntry{Coins: "10ufury", VestingType: vestingTypeContinuous, VestingEndTime: 200}},
+		{name: "continuous vesting ending before its start", entry: genesisAccountEntry{Coins: "10ufury", VestingStartTime: 200, VestingEndTime: 100}},
+		{name: "delayed vesting with start", entry: genesisAccountEntry{Coins: "10ufury", VestingType: vestingTypeDelayed, VestingStartTime: 100, VestingEndTime: 200}},
+		{name: "periods of continuous vesting", entry: genesisAccountEntry{Coins: "40ufury", VestingType: vestingTypeContinuous, VestingStartTime: 100, VestingEndTime: 400, VestingPeriods: periods}},
+		{name: "periodic vesting without start", entry: genesisAccountEntry{Coins: "40ufury", VestingPeriods: periods}},
+		{name: "periodic vesting greater than coins", entry: genesisAccountEntry{Coins: "20ufury", VestingStartTime: 100, VestingPeriods: periods}},
+		{name: "periodic vesting ending after its periods", entry: genesisAccountEntry{Coins: "40ufury", VestingStartTime: 100, VestingEndTime: 500, VestingPeriods: periods}},
+		{name: "periodic vesting amount not the total of its periods", entry: genesisAccountEntry{Coins: "40ufury", VestingAmount: "40ufury", VestingStartTime: 100, VestingPeriods: periods}},
+		{name: "empty period", entry: genesisAccountEntry{Coins: "40ufury", VestingStartTime: 100, VestingPeriods: []vestingPeriod{{LengthSeconds: 100}}}},
+	}
+
+	for _, tc := range testCases {
+		acc, balance, err := tc.entry.genesisAccount(addr)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, addr, acc.GetAddress(), tc.name)
+		require.Equal(t, addr.String(), balance.Address, tc.name)
+		require.Equal(t, tc.entry.Coins, balance.Coins.String(), tc.name)
+		if tc.check != nil {
+			tc.check(t, acc)
+		}
+	}
+}
+
+func TestBuildGenesisAccounts(t *testing.T) {
+	alice, bob := testGenesisAddress("alice"), testGenesisAddress("bob")
+	lookupAddress := func(addrOrName string) (sdk.AccAddress, error) {
+		if addrOrName == "bob" {
+			return bob, nil
+		}
+		return sdk.AccAddressFromBech32(addrOrName)
+	}
+
+	entries := []genesisAccountEntry{
+		{Address: alice.String(), Coins: "10ufury", pos: "line 2"},
+		{Address: "bob", Coins: "20ufury", VestingEndTime: 200, pos: "line 3"},
+		// repeated entries
+		{Address: alice.String(), Coins: "10ufury", pos: "line 4"},
+		{Address: bob.String(), Coins: "20ufury", VestingEndTime: 200, pos: "line 5"},
+	}
+
+	var warnings strings.Builder
+	accs, balances, errs := buildGenesisAccounts(entries, lookupAddress, false, &warnings)
+	require.Empty(t, errs)
+	require.Len(t, accs, 2)
+	require.Equal(t, []banktypes.Balance{
+		{Address: alice.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 10))},
+		{Address: bob.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 20))},
+	}, balances)
+	require.Contains(t, warnings.String(), "line 4: skipped the entry repeating the line 2 one")
+	require.Contains(t, warnings.String(), "line 5: skipped the entry repeating the line 3 one")
+
+	// the accounts without vesting listed more than once are merged on demand
+	entries = append(entries, genesisAccountEntry{Address: alice.String(), Coins: "5ufury,1uiris", pos: "line 6"})
+	_, _, errs = buildGenesisAccounts(entries, lookupAddress, false, io.Discard)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), "line 6: duplicate address")
+
+	_, balances, errs = buildGenesisAccounts(entries, lookupAddress, true, io.Discard)
+	require.Empty(t, errs)
+	require.Equal(t, "15ufury,1uiris", balances[0].Coins.String())
+
+	// the vesting accounts are never merged, and every invalid entry is reported
+	entries = append(entries,
+		genesisAccountEntry{Address: "bob", Coins: "20ufury", pos: "line 7"},
+		genesisAccountEntry{Address: "carol", Coins: "20ufury", pos: "line 8"},
+		genesisAccountEntry{Address: alice.String(), Coins: "-1ufury", pos: "line 9"},
+	)
+	_, _, errs = buildGenesisAccounts(entries, lookupAddress, true, io.Discard)
+	require.Len(t, errs, 3)
+	for i, pos := range []string{"line 7", "line 8", "line 9"} {
+		require.True(t, strings.HasPrefix(errs[i].Error(), pos+": "), errs[i].Error())
+	}
+}
+
+func TestAddGenesisAccounts(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	alice, bob, carol := testGenesisAddress("alice"), testGenesisAddress("bob"), testGenesisAddress("carol")
+
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{{Address: alice.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 10))}}
+	bankGenState.Supply = sdk.NewCoins(sdk.NewInt64Coin("ufury", 10))
+	authGenState := authtypes.DefaultGenesisState()
+	accs, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(alice, nil, 0, 0)})
+	require.NoError(t, err)
+	authGenState.Accounts = accs
+
+	genDoc := testGenesisDoc()
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	exportGenesis := func() {
+		appState, err := json.Marshal(map[string]json.RawMessage{
+			authtypes.ModuleName: cdc.MustMarshalJSON(authGenState),
+			banktypes.ModuleName: cdc.MustMarshalJSON(bankGenState),
+		})
+		require.NoError(t, err)
+		genDoc.AppState = appState
+		require.NoError(t, genutil.ExportGenesisFile(genDoc, genFile))
+	}
+	exportGenesis()
+
+	// existing account
+	balance := banktypes.Balance{Address: alice.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 1))}
+	err = addGenesisAccounts(cdc, genFile, authtypes.GenesisAccounts{authtypes.NewBaseAccount(alice, nil, 0, 0)}, []banktypes.Balance{balance}, nil)
+	require.ErrorContains(t, err, "existing address")
+
+	vesting := authvesting.NewDelayedVestingAccountRaw(
+		authvesting.NewBaseVestingAccount(authtypes.NewBaseAccount(carol, nil, 0, 0), sdk.NewCoins(sdk.NewInt64Coin("ufury", 30)), 200),
+	)
+	genAccounts := authtypes.GenesisAccounts{authtypes.NewBaseAccount(bob, nil, 0, 0), vesting}
+	balances := []banktypes.Balance{
+		{Address: bob.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 20), sdk.NewInt64Coin("uiris", 5))},
+		{Address: carol.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 30))},
+	}
+	supply := sdk.NewCoins(sdk.NewInt64Coin("ufury", 60), sdk.NewInt64Coin("uiris", 5))
+
+	// the supply is not increased by the imported coins
+	err = addGenesisAccounts(cdc, genFile, genAccounts, balances, nil)
+	require.ErrorContains(t, err, "not the genesis supply 10ufury")
+
+	bankGenState.Supply = supply
+	exportGenesis()
+	err = addGenesisAccounts(cdc, genFile, genAccounts, balances, sdk.NewCoins(sdk.NewInt64Coin("ufury", 60)))
+	require.ErrorContains(t, err, "not the expected supply 60ufury")
+	// as many other denoms are reported rather than compared
+	err = addGenesisAccounts(cdc, genFile, genAccounts, balances, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("ufury", 60)))
+	require.ErrorContains(t, err, "not the expected supply 5uatom,60ufury")
+	require.NoError(t, addGenesisAccounts(cdc, genFile, genAccounts, balances, supply))
+
+	appStateMap, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+	bankGenState = banktypes.GetGenesisStateFromAppState(cdc, appStateMap)
+	require.Len(t, bankGenState.Balances, 3)
+	require.Equal(t, supply, bankGenState.Supply)
+
+	unpacked, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(cdc, appStateMap).Accounts)
+	require.NoError(t, err)
+	require.Len(t, unpacked, 3)
+	require.True(t, unpacked.Contains(carol))
+
+	// without supply in the genesis file, the expected supply is checked alone
+	bankGenState.Supply = nil
+	bankGenState.Balances = []banktypes.Balance{{Address: alice.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 10))}}
+	exportGenesis()
+	err = addGenesisAccounts(cdc, genFile, genAccounts, balances, sdk.NewCoins(sdk.NewInt64Coin("ufury", 60), sdk.NewInt64Coin("uiris", 6)))
+	require.ErrorContains(t, err, "not the expected supply")
+	require.NoError(t, addGenesisAccounts(cdc, genFile, genAccounts, balances, nil))
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/furynet/furyhub/modules/guardian"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/mint"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+const (
+	flagType        = "type"
+	flagAddedBy     = "added-by"
+	flagInflation   = "inflation"
+	flagDenom       = "denom"
+	flagBase        = "base"
+	flagDescription = "description"
+)
+
+// genesisCmd returns the commands editing the module states of genesis.json
+func genesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Edit the module states of genesis.json",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		genesisAddSuperCmd(defaultNodeHome),
+		genesisSetMintCmd(defaultNodeHome),
+	)
+	return cmd
+}
+
+// genesisAddSuperCmd returns the command adding a guardian super to genesis.json
+func genesisAddSuperCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-super [address_or_key_name]",
+		Short: "Add a guardian super to genesis.json",
+		Long: `Add a guardian super to genesis.json. If a key name is given, the address will be
+looked up in the local Keybase. The super is added by itself unless --added-by is given.`,
+		Example: fmt.Sprintf(`$ %s genesis add-super <address> --type Genesis --description "genesis super"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			lookupAddress := genesisAccountAddress(cmd, clientCtx)
+			addr, err := lookupAddress(args[0])
+			if err != nil {
+				return err
+			}
+			addedBy := addr
+			if addedByStr, _ := cmd.Flags().GetString(flagAddedBy); addedByStr != "" {
+				if addedBy, err = lookupAddress(addedByStr); err != nil {
+					return err
+				}
+			}
+
+			typeStr, _ := cmd.Flags().GetString(flagType)
+			accountType, ok := guardiantypes.AccountType_value[strings.ToUpper(typeStr)]
+			if !ok {
+				return fmt.Errorf("invalid super type %s, must be Genesis or Ordinary", typeStr)
+			}
+			description, _ := cmd.Flags().GetString(flagDescription)
+
+			super := guardiantypes.NewSuper(description, guardiantypes.AccountType(accountType), addr, addedBy)
+
+			guardianGenState := guardiantypes.DefaultGenesisState()
+			return updateGenesisState(clientCtx.Codec, config.GenesisFile(), guardiantypes.ModuleName, guardianGenState, func() error {
+				for _, s := range guardianGenState.Supers {
+					if s.Address == super.Address {
+						return fmt.Errorf("cannot add super at existing address %s", super.Address)
+					}
+				}
+				guardianGenState.Supers = append(guardianGenState.Supers, super)
+				return guardian.ValidateGenesis(*guardianGenState)
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagType, "Genesis", "Type of the super (Genesis|Ordinary)")
+	cmd.Flags().String(flagDescription, "", "Description of the super")
+	cmd.Flags().String(flagAddedBy, "", "Address or key name of the account adding the super, the super itself if empty")
+
+	return cmd
+}
+
+// genesisSetMintCmd returns the command setting the mint params and minter of genesis.json
+func genesisSetMintCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint",
+		Short: "Set the mint params and inflation base of genesis.json",
+		Long: `Set the mint params and inflation base of genesis.json, the values of the flags
+not given being left as is.`,
+		Example: fmt.Sprintf(`$ %s genesis set-mint --inflation 0.04 --denom ufury --base 2000000000000000`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			mintGenState := minttypes.DefaultGenesisState()
+			return updateGenesisState(clientCtx.Codec, config.GenesisFile(), minttypes.ModuleName, mintGenState, func() error {
+				if cmd.Flags().Changed(flagInflation) {
+					inflationStr, _ := cmd.Flags().GetString(flagInflation)
+					inflation, err := sdk.NewDecFromStr(inflationStr)
+					if err != nil {
+						return fmt.Errorf("invalid inflation %s: %w", inflationStr, err)
+					}
+					mintGenState.Params.Inflation = inflation
+				}
+				if cmd.Flags().Changed(flagDenom) {
+					denom, _ := cmd.Flags().GetString(flagDenom)
+					if err := sdk.ValidateDenom(denom); err != nil {
+						return err
+					}
+					mintGenState.Params.MintDenom = denom
+				}
+				if cmd.Flags().Changed(flagBase) {
+					baseStr, _ := cmd.Flags().GetString(flagBase)
+					base, ok := sdk.NewIntFromString(baseStr)
+					if !ok {
+						return fmt.Errorf("invalid inflation base %s", baseStr)
+					}
+					mintGenState.Minter.InflationBase = base
+				}
+				return mint.ValidateGenesis(*mintGenState)
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagInflation, "", "Inflation rate, between 0 and 0.2")
+	cmd.Flags().String(flagDenom, "", "Denom of the minted coins")
+	cmd.Flags().String(flagBase, "", "Inflation base, the amount of min unit coins the inflation applies to")
+
+	return cmd
+}
+
+// updateGenesisState updates the state of a module in the genesis file, the
+// state being the given default one if the module has none. The genesis file
+// is written only if the update succeeds.
+func updateGenesisState(cdc codec.JSONCodec, genFile, moduleName string, state codec.ProtoMarshaler, update func() error) error {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if bz, ok := appState[moduleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, state); err != nil {
+			return fmt.Errorf("failed to unmarshal %s genesis state: %w", moduleName, err)
+		}
+	}
+	if err := update(); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", moduleName, err)
+	}
+
+	stateBz, err := cdc.MarshalJSON(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", moduleName, err)
+	}
+	appState[moduleName] = stateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
@@ -0,0 +1,544 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/furynet/furyhub/app"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+// genesisDiffCmd prints the semantic differences between the app states of two genesis files
+func genesisDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-diff [a.json] [b.json]",
+		Short: "Print the differences between the app states of two genesis files",
+		Long: `Decode the app state of both genesis files with the app codec and print, module
+by module, the accounts added or removed, the balance and supply deltas per
+denom, the param changes of every key ending in params, the guardian super changes and the token changes,
+followed by the list of modules whose state differs. Differences in the order
+of lists are ignored.`,
+		Example: fmt.Sprintf("$ %s debug genesis-diff exported-v1.3.json exported-v1.4.json --output json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, _ := cmd.Flags().GetString(cli.OutputFlag)
+			if output != outputText && output != outputJSON {
+				return fmt.Errorf("invalid output %s, expected %s or %s", output, outputText, outputJSON)
+			}
+
+			before, err := readAppState(args[0])
+			if err != nil {
+				return err
+			}
+			after, err := readAppState(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff, err := diffAppStates(app.MakeEncodingConfig().Marshaler, before, after)
+			if err != nil {
+				return err
+			}
+
+			if output == outputJSON {
+				bz, err := json.MarshalIndent(diff, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return nil
+			}
+			diff.WriteText(cmd.OutOrStdout())
+			return nil
+		},
+	}
+	cmd.Flags().String(cli.OutputFlag, outputText, "Output format (text|json)")
+	return cmd
+}
+
+// readAppState reads the app state of a genesis file by module
+func readAppState(path string) (map[string]json.RawMessage, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(bz, &doc); err != nil {
+		return nil, fmt.Errorf("failed to read the app state of %s: %w", path, err)
+	}
+	return doc.AppState, nil
+}
+
+// genesisDiff holds the semantic differences between two app states
+type genesisDiff struct {
+	ModulesAdded    []string       `json:"modules_added,omitempty"`
+	ModulesRemoved  []string       `json:"modules_removed,omitempty"`
+	ModulesChanged  []string       `json:"modules_changed,omitempty"`
+	AccountsAdded   []string       `json:"accounts_added,omitempty"`
+	AccountsRemoved []string       `json:"accounts_removed,omitempty"`
+	Balances        []amountChange `json:"balances,omitempty"`
+	Supply          []amountChange `json:"supply,omitempty"`
+	Params          []fieldChange  `json:"params,omitempty"`
+	Supers          []entryChange  `json:"supers,omitempty"`
+	Tokens          []entryChange  `json:"tokens,omitempty"`
+}
+
+// amountChange is the change of the amount of a denom, held by an address if set
+type amountChange struct {
+	Address string `json:"address,omitempty"`
+	Denom   string `json:"denom"`
+	Before  string `json:"before"`
+	After   string `json:"after"`
+	Delta   string `json:"delta"`
+}
+
+// fieldChange is the change of a field, as JSON, of a module if set
+type fieldChange struct {
+	Module string `json:"module,omitempty"`
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// entryChange is the addition, removal or change of an entry identified by ID
+type entryChange struct {
+	ID     string        `json:"id"`
+	Change string        `json:"change"`
+	Fields []fieldChange `json:"fields,omitempty"`
+}
+
+// Empty returns true if no difference was found
+func (d genesisDiff) Empty() bool {
+	return len(d.ModulesAdded)+len(d.ModulesRemoved)+len(d.ModulesChanged) == 0
+}
+
+// WriteText writes the differences in a human readable format
+func (d genesisDiff) WriteText(out io.Writer) {
+	if d.Empty() {
+		fmt.Fprintln(out, "no differences")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	section := func(title string, n int) bool {
+		if n > 0 {
+			fmt.Fprintf(w, "%s (%d):\n", title, n)
+		}
+		return n > 0
+	}
+
+	section("modules added", len(d.ModulesAdded))
+	for _, m := range d.ModulesAdded {
+		fmt.Fprintf(w, "  + %s\n", m)
+	}
+	section("modules removed", len(d.ModulesRemoved))
+	for _, m := range d.ModulesRemoved {
+		fmt.Fprintf(w, "  - %s\n", m)
+	}
+	section("accounts added", len(d.AccountsAdded))
+	for _, a := range d.AccountsAdded {
+		fmt.Fprintf(w, "  + %s\n", a)
+	}
+	section("accounts removed", len(d.AccountsRemoved))
+	for _, a := range d.AccountsRemoved {
+		fmt.Fprintf(w, "  - %s\n", a)
+	}
+	section("balances", len(d.Balances))
+	for _, c := range d.Balances {
+		fmt.Fprintf(w, "  %s\t%s\t%s -> %s\t(%s)\n", c.Address, c.Denom, c.Before, c.After, c.Delta)
+	}
+	section("supply", len(d.Supply))
+	for _, c := range d.Supply {
+		fmt.Fprintf(w, "  %s\t%s -> %s\t(%s)\n", c.Denom, c.Before, c.After, c.Delta)
+	}
+	section("params", len(d.Params))
+	for _, c := range d.Params {
+		fmt.Fprintf(w, "  %s.%s\t%s -> %s\n", c.Module, c.Field, c.Before, c.After)
+	}
+	writeEntryChanges(w, "guardian supers", d.Supers, section)
+	writeEntryChanges(w, "tokens", d.Tokens, section)
+	if section("modules changed", len(d.ModulesChanged)) {
+		fmt.Fprintf(w, "  %s\n", strings.Join(d.ModulesChanged, ", "))
+	}
+	_ = w.Flush()
+}
+
+func writeEntryChanges(w io.Writer, title string, changes []entryChange, section func(string, int) bool) {
+	section(title, len(changes))
+	for _, c := range changes {
+		switch c.Change {
+		case changeAdded:
+			fmt.Fprintf(w, "  + %s\n", c.ID)
+		case changeRemoved:
+			fmt.Fprintf(w, "  - %s\n", c.ID)
+		default:
+			fmt.Fprintf(w, "  ~ %s\n", c.ID)
+		}
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "      %s\t%s -> %s\n", f.Field, f.Before, f.After)
+		}
+	}
+}
+
+// diffAppStates returns the semantic differences between two app states
+func diffAppStates(cdc codec.Codec, before, after map[string]json.RawMessage) (genesisDiff, error) {
+	var diff genesisDiff
+
+	for _, m := range unionKeys(before, after) {
+		a, inBefore := before[m]
+		b, inAfter := after[m]
+		switch {
+		case !inBefore:
+			diff.ModulesAdded = append(diff.ModulesAdded, m)
+		case !inAfter:
+			diff.ModulesRemoved = append(diff.ModulesRemoved, m)
+		default:
+			na, err := normalizeJSON(a)
+			if err != nil {
+				return diff, fmt.Errorf("failed to decode %s genesis state: %w", m, err)
+			}
+			nb, err := normalizeJSON(b)
+			if err != nil {
+				return diff, fmt.Errorf("failed to decode %s genesis state: %w", m, err)
+			}
+			if canonicalJSON(na) == canonicalJSON(nb) {
+				continue
+			}
+			diff.ModulesChanged = append(diff.ModulesChanged, m)
+
+			for _, c := range paramChanges(na, nb) {
+				c.Module = m
+				diff.Params = append(diff.Params, c)
+			}
+		}
+	}
+
+	steps := []func(codec.Codec, map[string]json.RawMessage, map[string]json.RawMessage, *genesisDiff) error{
+		diffAccounts, diffBank, diffSupers, diffTokens,
+	}
+	for _, step := range steps {
+		if err := step(cdc, before, after, &diff); err != nil {
+			return diff, err
+		}
+	}
+	return diff, nil
+}
+
+// unmarshalModuleStates decodes the genesis state of a module in both app
+// states, returning false if the module is missing from either or unchanged
+func unmarshalModuleStates(
+	cdc codec.Codec, moduleName string,
+	before, after map[string]json.RawMessage,
+	a, b proto.Message,
+) (bool, error) {
+	bzA, okA := before[moduleName]
+	bzB, okB := after[moduleName]
+	if !okA || !okB || bytes.Equal(bzA, bzB) {
+		return false, nil
+	}
+	if err := cdc.UnmarshalJSON(bzA, a); err != nil {
+		return false, fmt.Errorf("failed to decode %s genesis state: %w", moduleName, err)
+	}
+	if err := cdc.UnmarshalJSON(bzB, b); err != nil {
+		return false, fmt.Errorf("failed to decode %s genesis state: %w", moduleName, err)
+	}
+	return true, nil
+}
+
+func diffAccounts(cdc codec.Codec, before, after map[string]json.RawMessage, diff *genesisDiff) error {
+	var a, b authtypes.GenesisState
+	if ok, err := unmarshalModuleStates(cdc, authtypes.ModuleName, before, after, &a, &b); !ok {
+		return err
+	}
+
+	addresses := func(gs authtypes.GenesisState) (map[string]bool, error) {
+		accounts, err := authtypes.UnpackAccounts(gs.Accounts)
+		if err != nil {
+			return nil, err
+		}
+		set := make(map[string]bool, len(accounts))
+		for _, acc := range accounts {
+			set[acc.GetAddress().String()] = true
+		}
+		return set, nil
+	}
+	setA, err := addresses(a)
+	if err != nil {
+		return err
+	}
+	setB, err := addresses(b)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range unionKeys(setA, setB) {
+		switch {
+		case !setA[addr]:
+			diff.AccountsAdded = append(diff.AccountsAdded, addr)
+		case !setB[addr]:
+			diff.AccountsRemoved = append(diff.AccountsRemoved, addr)
+		}
+	}
+	return nil
+}
+
+func diffBank(cdc codec.Codec, before, after map[string]json.RawMessage, diff *genesisDiff) error {
+	var a, b banktypes.GenesisState
+	if ok, err := unmarshalModuleStates(cdc, banktypes.ModuleName, before, after, &a, &b); !ok {
+		return err
+	}
+
+	balances := func(gs banktypes.GenesisState) map[string]sdk.Coins {
+		m := make(map[string]sdk.Coins, len(gs.Balances))
+		for _, balance := range gs.Balances {
+			m[balance.Address] = m[balance.Address].Add(balance.Coins...)
+		}
+		return m
+	}
+	balancesA, balancesB := balances(a), balances(b)
+	for _, addr := range unionKeys(balancesA, balancesB) {
+		for _, c := range amountChanges(balancesA[addr], balancesB[addr]) {
+			c.Address = addr
+			diff.Balances = append(diff.Balances, c)
+		}
+	}
+
+	diff.Supply = amountChanges(a.Supply, b.Supply)
+	return nil
+}
+
+func diffSupers(cdc codec.Codec, before, after map[string]json.RawMessage, diff *genesisDiff) error {
+	var a, b guardiantypes.GenesisState
+	if ok, err := unmarshalModuleStates(cdc, guardiantypes.ModuleName, before, after, &a, &b); !ok {
+		return err
+	}
+
+	supers := func(gs guardiantypes.GenesisState) map[string]proto.Message {
+		m := make(map[string]proto.Message, len(gs.Supers))
+		for i := range gs.Supers {
+			m[gs.Supers[i].Address] = &gs.Supers[i]
+		}
+		return m
+	}
+	changes, err := entryChanges(cdc, supers(a), supers(b))
+	diff.Supers = changes
+	return err
+}
+
+func diffTokens(cdc codec.Codec, before, after map[string]json.RawMessage, diff *genesisDiff) error {
+	var a, b tokentypes.GenesisState
+	if ok, err := unmarshalModuleStates(cdc, tokentypes.ModuleName, before, after, &a, &b); !ok {
+		return err
+	}
+
+	tokens := func(gs tokentypes.GenesisState) map[string]proto.Message {
+		m := make(map[string]proto.Message, len(gs.Tokens))
+		for i := range gs.Tokens {
+			m[gs.Tokens[i].Symbol] = &gs.Tokens[i]
+		}
+		return m
+	}
+	changes, err := entryChanges(cdc, tokens(a), tokens(b))
+	diff.Tokens = changes
+	return err
+}
+
+// amountChanges returns the non zero deltas per denom between two sets of coins
+func amountChanges(a, b sdk.Coins) []amountChange {
+	denoms := make(map[string]bool)
+	for _, coin := range append(append(sdk.Coins{}, a...), b...) {
+		denoms[coin.Denom] = true
+	}
+
+	var changes []amountChange
+	for _, denom := range unionKeys(denoms, nil) {
+		amountA, amountB := a.AmountOf(denom), b.AmountOf(denom)
+		if amountA.Equal(amountB) {
+			continue
+		}
+		delta := amountB.Sub(amountA).String()
+		if amountB.GT(amountA) {
+			delta = "+" + delta
+		}
+		changes = append(changes, amountChange{
+			Denom:  denom,
+			Before: amountA.String(),
+			After:  amountB.String(),
+			Delta:  delta,
+		})
+	}
+	return changes
+}
+
+// entryChanges returns the entries added, removed or changed, along with the
+// fields of the changed entries
+func entryChanges(cdc codec.Codec, a, b map[string]proto.Message) ([]entryChange, error) {
+	var changes []entryChange
+	for _, id := range unionKeys(a, b) {
+		entryA, inA := a[id]
+		entryB, inB := b[id]
+		switch {
+		case !inA:
+			changes = append(changes, entryChange{ID: id, Change: changeAdded})
+		case !inB:
+			changes = append(changes, entryChange{ID: id, Change: changeRemoved})
+		default:
+			na, err := normalizeProto(cdc, entryA)
+			if err != nil {
+				return nil, err
+			}
+			nb, err := normalizeProto(cdc, entryB)
+			if err != nil {
+				return nil, err
+			}
+			if fields := fieldChanges(na, nb); len(fields) > 0 {
+				changes = append(changes, entryChange{ID: id, Change: changeChanged, Fields: fields})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// paramChanges returns the changes of the params of two normalized module
+// states, the params being every top level key ending in params, such as the
+// deposit_params, voting_params and tally_params of gov. The fields of a key
+// other than params are prefixed with the key.
+func paramChanges(a, b interface{}) []fieldChange {
+	objA, _ := a.(map[string]interface{})
+	objB, _ := b.(map[string]interface{})
+
+	var changes []fieldChange
+	for _, key := range unionKeys(objA, objB) {
+		if !strings.HasSuffix(key, "params") {
+			continue
+		}
+		for _, c := range fieldChanges(objA[key], objB[key]) {
+			if key != "params" {
+				c.Field = key + "." + c.Field
+			}
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// fieldChanges returns the top level fields that differ between two
+// normalized JSON objects
+func fieldChanges(a, b interface{}) []fieldChange {
+	objA, _ := a.(map[string]interface{})
+	objB, _ := b.(map[string]interface{})
+
+	var changes []fieldChange
+	for _, field := range unionKeys(objA, objB) {
+		valueA, valueB := canonicalJSON(objA[field]), canonicalJSON(objB[field])
+		if valueA != valueB {
+			changes = append(changes, fieldChange{Field: field, Before: valueA, After: valueB})
+		}
+	}
+	return changes
+}
+
+func normalizeProto(cdc codec.Codec, msg proto.Message) (interface{}, error) {
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeJSON(bz)
+}
+
+// normalizeJSON decodes JSON keeping numbers as is, with the elements of
+// every list sorted so that ordering-only differences are ignored
+func normalizeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return sortLists(v), nil
+}
+
+func sortLists(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = sortLists(e)
+		}
+	case []interface{}:
+		keys := make([]string, len(v))
+		for i, e := range v {
+			v[i] = sortLists(e)
+			keys[i] = canonicalJSON(v[i])
+		}
+		sort.Sort(byKeys{v, keys})
+	}
+	return v
+}
+
+type byKeys struct {
+	values []interface{}
+	keys   []string
+}
+
+func (s byKeys) Len() int           { return len(s.values) }
+func (s byKeys) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s byKeys) Swap(i, j int) {
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// canonicalJSON encodes a normalized JSON value with sorted keys
+func canonicalJSON(v interface{}) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// unionKeys returns the sorted keys of both maps
+func unionKeys[V any](a, b map[string]V) []string {
+	set := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		set[k] = true
+	}
+	for k := range b {
+		set[k] = true
+	}
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
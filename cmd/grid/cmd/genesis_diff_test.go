@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/furynet/furyhub/app"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+)
+
+func TestDiffAppStates(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	appState := func(
+		accounts authtypes.GenesisAccounts,
+		balances []banktypes.Balance,
+		sendEnabled bool,
+		supers []guardiantypes.Super,
+		tokens []tokentypes.Token,
+	) map[string]json.RawMessage {
+		auth := authtypes.NewGenesisState(authtypes.DefaultParams(), accounts)
+		bankParams := banktypes.DefaultParams()
+		bankParams.DefaultSendEnabled = sendEnabled
+		var supply sdk.Coins
+		for _, balance := range balances {
+			supply = supply.Add(balance.Coins...)
+		}
+		bank := banktypes.NewGenesisState(bankParams, balances, supply, nil)
+		guardian := guardiantypes.NewGenesisState(supers, nil)
+		token := tokentypes.GenesisState{Params: tokentypes.DefaultParams(), Tokens: tokens}
+		return map[string]json.RawMessage{
+			authtypes.ModuleName:     cdc.MustMarshalJSON(auth),
+			banktypes.ModuleName:     cdc.MustMarshalJSON(bank),
+			guardiantypes.ModuleName: cdc.MustMarshalJSON(guardian),
+			tokentypes.ModuleName:    cdc.MustMarshalJSON(&token),
+		}
+	}
+
+	acc1 := authtypes.NewBaseAccountWithAddress(addr1)
+	acc2 := authtypes.NewBaseAccountWithAddress(addr2)
+	acc3 := authtypes.NewBaseAccountWithAddress(addr3)
+	super1 := guardiantypes.NewSuper("genesis", guardiantypes.Genesis, addr1, addr1)
+	super2 := guardiantypes.NewSuper("ops", guardiantypes.Ordinary, addr2, addr1)
+	token := tokentypes.NewToken("btc", "Bitcoin", "satoshi", 8, 21000000, 21000000, true, addr1)
+	balance := func(addr sdk.AccAddress, amount int64) banktypes.Balance {
+		return banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", amount))}
+	}
+
+	before := appState(
+		authtypes.GenesisAccounts{acc1, acc2},
+		[]banktypes.Balance{balance(addr1, 100), balance(addr2, 50)},
+		true,
+		[]guardiantypes.Super{super1, super2},
+		[]tokentypes.Token{token},
+	)
+
+	// ordering only
+	reordered := appState(
+		authtypes.GenesisAccounts{acc2, acc1},
+		[]banktypes.Balance{balance(addr2, 50), balance(addr1, 100)},
+		true,
+		[]guardiantypes.Super{super2, super1},
+		[]tokentypes.Token{token},
+	)
+	diff, err := diffAppStates(cdc, before, reordered)
+	require.NoError(t, err)
+	require.True(t, diff.Empty(), diff)
+
+	var buf bytes.Buffer
+	diff.WriteText(&buf)
+	require.Equal(t, "no differences\n", buf.String())
+
+	super2.Description = "operations"
+	token.MaxSupply = 42000000
+	after := appState(
+		authtypes.GenesisAccounts{acc3, acc1},
+		[]banktypes.Balance{balance(addr1, 80), balance(addr3, 90)},
+		false,
+		[]guardiantypes.Super{super2},
+		[]tokentypes.Token{token},
+	)
+	after["ratelimit"] = json.RawMessage(`{"rate_limits":[]}`)
+	before["gov"] = json.RawMessage(`{"deposit_params":{"min_deposit":[{"denom":"ufury","amount":"10"}]},"voting_params":{"voting_period":"172800s"}}`)
+	after["gov"] = json.RawMessage(`{"deposit_params":{"min_deposit":[{"denom":"ufury","amount":"20"}]},"voting_params":{"voting_period":"172800s"}}`)
+
+	diff, err = diffAppStates(cdc, before, after)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ratelimit"}, diff.ModulesAdded)
+	require.Empty(t, diff.ModulesRemoved)
+	require.Equal(t, []string{"auth", "bank", "gov", "guardian", "token"}, diff.ModulesChanged)
+	require.Equal(t, []string{addr3.String()}, diff.AccountsAdded)
+	require.Equal(t, []string{addr2.String()}, diff.AccountsRemoved)
+
+	require.Len(t, diff.Balances, 3)
+	deltas := make(map[string]string)
+	for _, c := range diff.Balances {
+		deltas[c.Address] = c.Delta
+	}
+	require.Equal(t, "-20", deltas[addr1.String()])
+	require.Equal(t, "-50", deltas[addr2.String()])
+	require.Equal(t, "+90", deltas[addr3.String()])
+	require.Equal(t, []amountChange{{Denom: "ufury", Before: "150", After: "170", Delta: "+20"}}, diff.Supply)
+
+	require.Equal(t, []fieldChange{
+		{Module: "bank", Field: "default_send_enabled", Before: "true", After: "false"},
+		{Module: "gov", Field: "deposit_params.min_deposit", Before: `[{"amount":"10","denom":"ufury"}]`, After: `[{"amount":"20","denom":"ufury"}]`},
+	}, diff.Params)
+
+	require.Equal(t, []entryChange{
+		{ID: addr1.String(), Change: changeRemoved},
+		{ID: addr2.String(), Change: changeChanged, Fields: []fieldChange{
+			{Field: "description", Before: `"ops"`, After: `"operations"`},
+		}},
+	}, diff.Supers)
+
+	require.Len(t, diff.Tokens, 1)
+	require.Equal(t, "btc", diff.Tokens[0].ID)
+	require.Equal(t, changeChanged, diff.Tokens[0].Change)
+	require.Equal(t, "max_supply", diff.Tokens[0].Fields[0].Field)
+
+	buf.Reset()
+	diff.WriteText(&buf)
+	require.Contains(t, buf.String(), "accounts added (1):")
+	require.Regexp(t, `bank\.default_send_enabled +true -> false`, buf.String())
+	require.Contains(t, buf.String(), "gov.deposit_params.min_deposit")
+	require.Contains(t, buf.String(), "~ btc")
+
+	_, err = diffAppStates(cdc, before, map[string]json.RawMessage{authtypes.ModuleName: json.RawMessage(`{`)})
+	require.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/furynet/furyhub/app"
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+// executeGenesisCmd runs a genesis command on the genesis file of the home
+func executeGenesisCmd(t *testing.T, cdc codec.Codec, home string, args ...string) error {
+	t.Helper()
+	cmd := genesisCmd(home)
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SilenceErrors = true
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	clientCtx := client.Context{}.WithCodec(cdc).WithHomeDir(home)
+
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	return cmd.ExecuteContext(ctx)
+}
+
+func TestGenesisCmd(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	home := t.TempDir()
+	genFile := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(genFile), 0o700))
+
+	// the genesis file has no guardian state, the default one is updated
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		minttypes.ModuleName: cdc.MustMarshalJSON(minttypes.DefaultGenesisState()),
+	})
+	require.NoError(t, err)
+	genDoc := testGenesisDoc()
+	genDoc.AppState = appState
+	require.NoError(t, genutil.ExportGenesisFile(genDoc, genFile))
+
+	alice, bob := testGenesisAddress("alice"), testGenesisAddress("bob")
+
+	require.NoError(t, executeGenesisCmd(t, cdc, home, "add-super", alice.String(), "--description", "genesis super"))
+	require.NoError(t, executeGenesisCmd(t, cdc, home, "add-super", bob.String(), "--type", "Ordinary", "--added-by", alice.String()))
+	require.Error(t, executeGenesisCmd(t, cdc, home, "add-super", bob.String()))
+	require.Error(t, executeGenesisCmd(t, cdc, home, "add-super", testGenesisAddress("carol").String(), "--type", "Root"))
+
+	require.NoError(t, executeGenesisCmd(t, cdc, home, "set-mint", "--inflation", "0.08", "--base", "2000000000000000"))
+	require.NoError(t, executeGenesisCmd(t, cdc, home, "set-mint", "--denom", "ufury"))
+	for _, args := range [][]string{
+		{"--inflation", "0.3"},
+		{"--inflation", "-0.01"},
+		{"--denom", "1ufury"},
+		{"--base", "0"},
+		{"--base", "1.5"},
+	} {
+		require.Error(t, executeGenesisCmd(t, cdc, home, append([]string{"set-mint"}, args...)...), args)
+	}
+
+	appStateMap, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	var guardianGenState guardiantypes.GenesisState
+	cdc.MustUnmarshalJSON(appStateMap[guardiantypes.ModuleName], &guardianGenState)
+	require.Equal(t, []guardiantypes.Super{
+		guardiantypes.NewSuper("genesis super", guardiantypes.Genesis, alice, alice),
+		guardiantypes.NewSuper("", guardiantypes.Ordinary, bob, alice),
+	}, guardianGenState.Supers)
+
+	var mintGenState minttypes.GenesisState
+	cdc.MustUnmarshalJSON(appStateMap[minttypes.ModuleName], &mintGenState)
+	require.Equal(t, "ufury", mintGenState.Params.MintDenom)
+	require.Equal(t, sdk.NewDecWithPrec(8, 2), mintGenState.Params.Inflation)
+	require.Equal(t, sdk.NewInt(2000000000000000), mintGenState.Minter.InflationBase)
+}
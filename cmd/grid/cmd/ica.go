@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
+
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+const (
+	flagAdd      = "add"
+	flagRemove   = "remove"
+	flagDefaults = "defaults"
+	flagTitle    = "title"
+	flagDesc     = "description"
+	flagDeposit  = "deposit"
+
+	icaHostWildcard = "*"
+)
+
+// icaHostQueryCommand returns the commands inspecting the interchain accounts host allow-list
+func icaHostQueryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "ica-host",
+		Short:                      "Querying commands for the interchain accounts host allow-list",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		icaHostAllowedMessagesCmd(),
+	)
+	return cmd
+}
+
+// icaHostTxCommand returns the commands building the proposals updating the
+// interchain accounts host allow-list
+func icaHostTxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "ica-host",
+		Short:                      "Interchain accounts host allow-list proposal subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		icaHostParamChangeProposalCmd(),
+	)
+	return cmd
+}
+
+// allowedMessage is the effective permission of a message type on the host chain
+type allowedMessage struct {
+	TypeURL    string `json:"type_url" yaml:"type_url"`
+	Allowed    bool   `json:"allowed" yaml:"allowed"`
+	Registered bool   `json:"registered" yaml:"registered"`
+}
+
+// allowedMessages is the output of the allowed-messages command
+type allowedMessages struct {
+	HostEnabled bool             `json:"host_enabled" yaml:"host_enabled"`
+	Wildcard    bool             `json:"wildcard" yaml:"wildcard"`
+	Messages    []allowedMessage `json:"messages" yaml:"messages"`
+}
+
+func icaHostAllowedMessagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-messages [pattern]",
+		Short: "Query the message types interchain accounts may execute on this chain",
+		Long: `Query the message types interchain accounts may execute on this chain.
+
+The allow-list of the icahost params is resolved against the message types known
+by this binary, so that a wildcard ("*") allow-list shows every message it grants.
+An optional pattern (e.g. "/irismod.token.*") filters the listed message types.`,
+		Example: fmt.Sprintf("%s query ica-host allowed-messages /irismod.*", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pattern := icaHostWildcard
+			if len(args) == 1 {
+				pattern = args[0]
+			}
+
+			queryClient := icahosttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &icahosttypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(resolveAllowedMessages(*res.Params, clientCtx.InterfaceRegistry, pattern))
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// globRegexp compiles a pattern where "*" matches any sequence of characters
+func globRegexp(pattern string) *regexp.Regexp {
+	quoted := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	return regexp.MustCompile("^" + quoted + "$")
+}
+
+// resolveAllowedMessages lists the registered and the configured message types
+// matching pattern along with the permission the host grants them, following
+// the semantics of icahosttypes.ContainsMsgType
+func resolveAllowedMessages(params icahosttypes.Params, registry codectypes.InterfaceRegistry, pattern string) allowedMessages {
+	wildcard := len(params.AllowMessages) == 1 && params.AllowMessages[0] == icaHostWildcard
+
+	configured := make(map[string]bool, len(params.AllowMessages))
+	for _, typeURL := range params.AllowMessages {
+		configured[typeURL] = true
+	}
+
+	registered := make(map[string]bool)
+	for _, typeURL := range registry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		registered[typeURL] = true
+	}
+
+	typeURLs := make(map[string]bool, len(registered)+len(configured))
+	for typeURL := range registered {
+		typeURLs[typeURL] = true
+	}
+	for typeURL := range configured {
+		if typeURL != icaHostWildcard {
+			typeURLs[typeURL] = true
+		}
+	}
+
+	output := allowedMessages{
+		HostEnabled: params.HostEnabled,
+		Wildcard:    wildcard,
+		Messages:    []allowedMessage{},
+	}
+	matcher := globRegexp(pattern)
+	for typeURL := range typeURLs {
+		if !matcher.MatchString(typeURL) {
+			continue
+		}
+		output.Messages = append(output.Messages, allowedMessage{
+			TypeURL:    typeURL,
+			Allowed:    params.HostEnabled && (wildcard || configured[typeURL]),
+			Registered: registered[typeURL],
+		})
+	}
+	sort.Slice(output.Messages, func(i, j int) bool {
+		return output.Messages[i].TypeURL < output.Messages[j].TypeURL
+	})
+	return output
+}
+
+func icaHostParamChangeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "param-change-proposal",
+		Short: "Build a param change proposal updating the interchain accounts host allow-list",
+		Long: fmt.Sprintf(`Build a param change proposal updating the interchain accounts host allow-list.
+
+The current allow-list of the chain (or the curated default one with --%s) is
+updated with the message types given by --%s and --%s, the resulting proposal
+is printed and can be submitted with "%s tx gov submit-legacy-proposal param-change".`,
+			flagDefaults, flagAdd, flagRemove, version.AppName),
+		Example: fmt.Sprintf(
+			"%s tx ica-host param-change-proposal --add=/irismod.oracle.MsgCreateFeed --deposit=1000fury > proposal.json",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var allowMessages []string
+			if defaults, _ := cmd.Flags().GetBool(flagDefaults); defaults {
+				allowMessages = app.ICAHostAllowMessages()
+			} else {
+				queryClient := icahosttypes.NewQueryClient(clientCtx)
+				res, err := queryClient.Params(cmd.Context(), &icahosttypes.QueryParamsRequest{})
+				if err != nil {
+					return err
+				}
+				allowMessages = res.Params.AllowMessages
+			}
+
+			add, _ := cmd.Flags().GetStringSlice(flagAdd)
+			remove, _ := cmd.Flags().GetStringSlice(flagRemove)
+			allowMessages = updateAllowMessages(allowMessages, add, remove)
+			if err := icahosttypes.NewParams(true, allowMessages).Validate(); err != nil {
+				return err
+			}
+
+			value, err := clientCtx.LegacyAmino.MarshalJSON(allowMessages)
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(flagTitle)
+			description, _ := cmd.Flags().GetString(flagDesc)
+			deposit, _ := cmd.Flags().GetString(flagDeposit)
+			proposal := paramsutils.ParamChangeProposalJSON{
+				Title:       title,
+				Description: description,
+				Changes: paramsutils.ParamChangesJSON{
+					paramsutils.NewParamChangeJSON(icahosttypes.SubModuleName, string(icahosttypes.KeyAllowMessages), value),
+				},
+				Deposit: deposit,
+			}
+
+			out, err := json.MarshalIndent(proposal, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(out) + "\n")
+		},
+	}
+	cmd.Flags().StringSlice(flagAdd, nil, "message type urls to add to the allow-list")
+	cmd.Flags().StringSlice(flagRemove, nil, "message type urls to remove from the allow-list")
+	cmd.Flags().Bool(flagDefaults, false, "start from the curated default allow-list instead of the one of the chain")
+	cmd.Flags().String(flagTitle, "Update the interchain accounts host allow-list", "title of the proposal")
+	cmd.Flags().String(flagDesc, "Update the message types interchain accounts may execute", "description of the proposal")
+	cmd.Flags().String(flagDeposit, "", "deposit of the proposal")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// updateAllowMessages adds and removes message types from the allow-list, keeping its order
+func updateAllowMessages(allowMessages, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, typeURL := range remove {
+		removed[typeURL] = true
+	}
+
+	seen := make(map[string]bool, len(allowMessages)+len(add))
+	result := make([]string, 0, len(allowMessages)+len(add))
+	for _, typeURL := range append(append([]string{}, allowMessages...), add...) {
+		if removed[typeURL] || seen[typeURL] {
+			continue
+		}
+		seen[typeURL] = true
+		result = append(result, typeURL)
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+func TestResolveAllowedMessages(t *testing.T) {
+	registry := app.MakeEncodingConfig().InterfaceRegistry
+	send := "/cosmos.bank.v1beta1.MsgSend"
+	issue := "/irismod.token.MsgIssueToken"
+
+	output := resolveAllowedMessages(icahosttypes.NewParams(true, []string{send, "/unknown.Msg"}), registry, "*")
+	require.False(t, output.Wildcard)
+	allowed := make(map[string]allowedMessage)
+	for _, msg := range output.Messages {
+		allowed[msg.TypeURL] = msg
+	}
+	require.True(t, allowed[send].Allowed)
+	require.False(t, allowed[issue].Allowed)
+	require.True(t, allowed["/unknown.Msg"].Allowed)
+	require.False(t, allowed["/unknown.Msg"].Registered)
+
+	output = resolveAllowedMessages(icahosttypes.NewParams(true, []string{"*"}), registry, "/irismod.token.*")
+	require.True(t, output.Wildcard)
+	require.NotEmpty(t, output.Messages)
+	for _, msg := range output.Messages {
+		require.Regexp(t, `^/irismod\.token\.`, msg.TypeURL)
+		require.True(t, msg.Allowed)
+	}
+
+	output = resolveAllowedMessages(icahosttypes.NewParams(false, []string{"*"}), registry, send)
+	require.Len(t, output.Messages, 1)
+	require.False(t, output.Messages[0].Allowed)
+}
+
+func TestUpdateAllowMessages(t *testing.T) {
+	require.Equal(t,
+		[]string{"/a.MsgA", "/c.MsgC"},
+		updateAllowMessages([]string{"/a.MsgA", "/b.MsgB"}, []string{"/c.MsgC", "/a.MsgA"}, []string{"/b.MsgB"}),
+	)
+}
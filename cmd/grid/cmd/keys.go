@@ -3,7 +3,11 @@ package cmd
 import (
 	"bufio"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
 	"github.com/furynet/furyhub/keystore"
 )
@@ -52,6 +57,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		keys.AddKeyCommand(),
 		keys.ExportKeyCommand(),
 		importKeyCommand(),
+		importBatchCommand(),
+		exportKeyStoreCommand(),
 		keys.ListKeysCmd(),
 		keys.ShowKeysCmd(),
 		flags.LineBreak,
@@ -100,6 +107,259 @@ func importKeyCommand() *cobra.Command {
 	}
 }
 
+const flagPassphraseFile = "passphrase-file"
+
+// batchKey is a keystore file of a batch import
+type batchKey struct {
+	Name           string `json:"name"`
+	File           string `json:"file"`
+	PassphraseFile string `json:"passphrase_file,omitempty"`
+}
+
+// batchKeyResult is the result of the import of a keystore file
+type batchKeyResult struct {
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Address string `json:"address,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+func importBatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-batch <dir|manifest>",
+		Short: "Import many keystore or ASCII armored private keys into the local keybase",
+		Long: `Import the keystore or ASCII armored private keys of a directory, or listed in a manifest, into the local keybase.
+
+The keys of a directory are named after their files without extension, and are decrypted with a shared passphrase.
+A manifest is a JSON file listing the keys, the paths being relative to the manifest:
+
+[
+  {"name": "operator1", "file": "operator1.json", "passphrase_file": "operator1.txt"},
+  {"name": "operator2", "file": "operator2.json"}
+]
+
+The keys without passphrase file are decrypted with the shared passphrase, read from --passphrase-file or prompted once.
+The keys failing to import are reported and do not stop the import of the others.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batch, err := readBatchKeys(args[0])
+			if err != nil {
+				return err
+			}
+
+			sharedPassphraseFile, _ := cmd.Flags().GetString(flagPassphraseFile)
+			var sharedPassphrase string
+			getSharedPassphrase := func() (passphrase string, err error) {
+				if sharedPassphrase != "" {
+					return sharedPassphrase, nil
+				}
+				if sharedPassphraseFile != "" {
+					passphrase, err = readPassphrase(sharedPassphraseFile)
+				} else {
+					passphrase, err = input.GetPassword("Enter passphrase to decrypt your keys:", buf)
+				}
+				sharedPassphrase = passphrase
+				return passphrase, err
+			}
+
+			var imported, failed []batchKeyResult
+			for _, key := range batch {
+				result := batchKeyResult{Name: key.Name, File: key.File}
+				address, err := importBatchKey(clientCtx, key, getSharedPassphrase)
+				if err != nil {
+					result.Error = err.Error()
+					failed = append(failed, result)
+					continue
+				}
+				result.Address = address
+				imported = append(imported, result)
+			}
+
+			out, err := json.Marshal(map[string][]batchKeyResult{
+				"imported": imported,
+				"failed":   failed,
+			})
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.WithOutput(cmd.OutOrStdout()).PrintRaw(out); err != nil {
+				return err
+			}
+			if len(failed) > 0 {
+				// the failures are reported, not a misuse of the command
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%d of %d keys failed to import", len(failed), len(batch))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPassphraseFile, "", "File holding the shared passphrase of the keys")
+	return cmd
+}
+
+// readBatchKeys returns the keys of a directory or a manifest
+func readBatchKeys(path string) ([]batchKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var batch []batchKey
+		if err := json.Unmarshal(bz, &batch); err != nil {
+			return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+		}
+		dir := filepath.Dir(path)
+		for i, key := range batch {
+			if key.Name == "" || key.File == "" {
+				return nil, fmt.Errorf("invalid manifest %s: entry %d has no name or file", path, i)
+			}
+			batch[i].File = resolvePath(dir, key.File)
+			if key.PassphraseFile != "" {
+				batch[i].PassphraseFile = resolvePath(dir, key.PassphraseFile)
+			}
+		}
+		return batch, nil
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var batch []batchKey
+	for _, entry := range entries {
+		if !entry.Mode().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		batch = append(batch, batchKey{
+			Name: strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())),
+			File: filepath.Join(path, entry.Name()),
+		})
+	}
+	return batch, nil
+}
+
+// importBatchKey imports a key of a batch and returns its address
+func importBatchKey(clientCtx client.Context, key batchKey, getSharedPassphrase func() (string, error)) (string, error) {
+	bz, err := ioutil.ReadFile(key.File)
+	if err != nil {
+		return "", err
+	}
+
+	var passphrase string
+	if key.PassphraseFile != "" {
+		passphrase, err = readPassphrase(key.PassphraseFile)
+	} else {
+		passphrase, err = getSharedPassphrase()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	armor, err := getArmor(bz, passphrase)
+	if err != nil {
+		return "", err
+	}
+	if err := clientCtx.Keyring.ImportPrivKey(key.Name, armor, passphrase); err != nil {
+		return "", err
+	}
+
+	record, err := clientCtx.Keyring.Key(key.Name)
+	if err != nil {
+		return "", err
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return "", err
+	}
+	return address.String(), nil
+}
+
+func readPassphrase(path string) (string, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// privKeyExporter is implemented by the keyrings exporting unarmored private keys
+type privKeyExporter interface {
+	ExportPrivateKeyObject(uid string) (cryptotypes.PrivKey, error)
+}
+
+func exportKeyStoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-keystore <name> <keyfile>",
+		Short: "Export a private key from the local keybase to a keystore file",
+		Long:  "Export a secp256k1 private key from the local keybase to a Web3 style encrypted JSON keystore file, which \"keys import\" imports back.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			address, err := record.GetAddress()
+			if err != nil {
+				return err
+			}
+
+			exporter, ok := clientCtx.Keyring.(privKeyExporter)
+			if !ok {
+				return fmt.Errorf("the keyring does not export private keys")
+			}
+			privKey, err := exporter.ExportPrivateKeyObject(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+			if err != nil {
+				return err
+			}
+
+			bz, err := keystore.ExportKeyStore(privKey, address.String(), passphrase)
+			if err != nil {
+				return err
+			}
+
+			// an existing keystore file is never overwritten
+			file, err := os.OpenFile(args[1], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			if _, err := file.Write(bz); err != nil {
+				_ = file.Close()
+				return err
+			}
+			return file.Close()
+		},
+	}
+}
+
 func getArmor(privBytes []byte, passphrase string) (string, error) {
 	if !json.Valid(privBytes) {
 		return string(privBytes), nil
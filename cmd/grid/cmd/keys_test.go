@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/app"
+	"github.com/furynet/furyhub/keystore"
+)
+
+func runKeysCmd(t *testing.T, home, stdin string, args ...string) (string, error) {
+	cmd := Commands(home)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetArgs(append(args, "--"+flags.FlagKeyringBackend, keyring.BackendTest, "--output", "json"))
+
+	clientCtx := client.Context{}.WithCodec(app.MakeEncodingConfig().Marshaler).WithKeyringDir(home)
+	err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	return out.String(), err
+}
+
+func writeKeyStore(t *testing.T, path, passphrase string) string {
+	privKey := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(privKey.PubKey().Address()).String()
+	bz, err := keystore.ExportKeyStore(privKey, address, passphrase)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	return address
+}
+
+func TestImportBatch(t *testing.T) {
+	home := t.TempDir()
+	dir := t.TempDir()
+
+	operator1 := writeKeyStore(t, filepath.Join(dir, "operator1.json"), "shared-passphrase")
+	operator2 := writeKeyStore(t, filepath.Join(dir, "operator2.json"), "operator2-passphrase")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "operator2.txt"), []byte("operator2-passphrase\n"), 0o600))
+	writeKeyStore(t, filepath.Join(dir, "operator3.json"), "another-passphrase")
+
+	manifest := filepath.Join(dir, "manifest.json")
+	require.NoError(t, os.WriteFile(manifest, []byte(`[
+		{"name": "operator1", "file": "operator1.json"},
+		{"name": "operator2", "file": "operator2.json", "passphrase_file": "operator2.txt"},
+		{"name": "operator3", "file": "operator3.json"},
+		{"name": "operator4", "file": "missing.json"}
+	]`), 0o600))
+
+	out, err := runKeysCmd(t, home, "shared-passphrase\n", "import-batch", manifest)
+	require.EqualError(t, err, "2 of 4 keys failed to import")
+
+	var report map[string][]batchKeyResult
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	require.Len(t, report["imported"], 2)
+	require.Equal(t, operator1, report["imported"][0].Address)
+	require.Equal(t, operator2, report["imported"][1].Address)
+	require.Len(t, report["failed"], 2)
+	require.Equal(t, "operator3", report["failed"][0].Name)
+	require.Contains(t, report["failed"][0].Error, "could not decrypt key")
+	require.Equal(t, filepath.Join(dir, "missing.json"), report["failed"][1].File)
+
+	// the keys of a directory share the passphrase
+	dir = t.TempDir()
+	operator5 := writeKeyStore(t, filepath.Join(dir, "operator5.json"), "shared-passphrase")
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("shared-passphrase"), 0o600))
+
+	out, err = runKeysCmd(t, home, "", "import-batch", dir, "--"+flagPassphraseFile, passphraseFile)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	require.Len(t, report["imported"], 1)
+	require.Equal(t, "operator5", report["imported"][0].Name)
+	require.Equal(t, operator5, report["imported"][0].Address)
+}
+
+func TestExportKeyStore(t *testing.T) {
+	home := t.TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil, app.MakeEncodingConfig().Marshaler)
+	require.NoError(t, err)
+	record, _, err := kr.NewMnemonic("operator", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "operator.json")
+	_, err = runKeysCmd(t, home, "passphrase\n", "export-keystore", "operator", keyFile)
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(keyFile)
+	require.NoError(t, err)
+	var encryptedKey keystore.EncryptedKeyJSON
+	require.NoError(t, json.Unmarshal(bz, &encryptedKey))
+	require.Equal(t, address.String(), encryptedKey.Address)
+
+	// the keystore file is imported back
+	_, err = runKeysCmd(t, home, "passphrase\n", "import", "imported", keyFile)
+	require.NoError(t, err)
+	imported, err := kr.Key("imported")
+	require.NoError(t, err)
+	importedAddress, err := imported.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, importedAddress)
+
+	// an existing keystore file is not overwritten
+	_, err = runKeysCmd(t, home, "passphrase\n", "export-keystore", "operator", keyFile)
+	require.ErrorIs(t, err, os.ErrExist)
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -33,6 +35,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
+	"github.com/furynet/furyhub/address"
 	"github.com/furynet/furyhub/app"
 	"github.com/furynet/furyhub/app/params"
 )
@@ -70,15 +73,18 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
-			converter.handlePreRun(cmd, args)
+			if err := convertLegacyAddresses(cmd, args); err != nil {
+				return err
+			}
+
+			if err := converter.handlePreRun(cmd, args); err != nil {
+				return err
+			}
 
 			customTemplate, customGRIDHubConfig := initAppConfig()
 			customTMConfig := initTendermintConfig()
 			return server.InterceptConfigsPreRunHandler(cmd, customTemplate, customGRIDHubConfig, customTMConfig)
 		},
-		PersistentPostRun: func(cmd *cobra.Command, _ []string) {
-			converter.handlePostRun(cmd)
-		},
 	}
 
 	initRootCmd(rootCmd, encodingConfig)
@@ -114,31 +120,57 @@ func initAppConfig() (string, interface{}) {
 }
 
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
+	ac := appCreator{
+		encCfg: encodingConfig,
+	}
+
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(genesisDiffCmd(), convertAddressCmd())
+
+	testnet := testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{})
+	testnet.AddCommand(
+		testnetForkCmd(ac, app.DefaultNodeHome),
+		testnetInPlaceCmd(ac, app.DefaultNodeHome),
+	)
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		AddGenesisAccountsCmd(app.DefaultNodeHome),
+		genesisCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
-		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		testnet,
+		upgradeCommand(),
+		debugCmd,
 		config.Cmd(),
 	)
 
-	ac := appCreator{
-		encCfg: encodingConfig,
-	}
-
 	server.AddCommands(rootCmd, app.DefaultNodeHome, ac.newApp, ac.appExport, addModuleInitFlags)
 
+	// replace the sdk export command with the streaming one
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "export" {
+			rootCmd.RemoveCommand(c)
+		}
+	}
+	rootCmd.AddCommand(exportCmd(ac, app.DefaultNodeHome))
+
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		tokensCommand(),
 		Commands(app.DefaultNodeHome),
 	)
+
+	rootCmd.PersistentFlags().StringSlice(flagLegacyPrefixes, nil, fmt.Sprintf(
+		"Legacy chain prefixes (%s) of the addresses to accept in the address arguments and flags, converting them to the addresses of this chain with a warning",
+		strings.Join(address.LegacyChainPrefixes, "|"),
+	))
 }
 
 func addModuleInitFlags(rootCmd *cobra.Command) {
@@ -161,6 +193,7 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		icaHostQueryCommand(),
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
@@ -187,10 +220,13 @@ func txCommand() *cobra.Command {
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		flags.LineBreak,
+		icaHostTxCommand(),
 	)
 
 	app.ModuleBasics.AddTxCommands(cmd)
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.PersistentFlags().String(flagTokenMetadata, "", "Token metadata file, as written by \"tokens sync\", to convert the main unit amounts with instead of the token cache and the node")
 
 	return cmd
 }
@@ -262,9 +298,25 @@ func (ac appCreator) appExport(
 ) (
 	servertypes.ExportedApp, error,
 ) {
+	gridApp, err := ac.loadApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return gridApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+}
+
+// loadApp creates a new gridapp loaded at the given height, or at the latest height if -1.
+func (ac appCreator) loadApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (*app.GridApp, error) {
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home is not set")
+		return nil, errors.New("application home is not set")
 	}
 
 	var loadLatest bool
@@ -286,9 +338,8 @@ func (ac appCreator) appExport(
 
 	if height != -1 {
 		if err := gridApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			return nil, err
 		}
 	}
-
-	return gridApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	return gridApp, nil
 }
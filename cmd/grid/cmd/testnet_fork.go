@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+const (
+	flagSkipInvariants = "skip-invariants"
+	flagKeepRewards    = "keep-rewards"
+	flagValidator      = "validator"
+	flagBalance        = "balance"
+)
+
+// testnetForkCmd exports the state of a node as the genesis of a testnet
+func testnetForkCmd(ac appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fork",
+		Short: "Export the state of a node as the genesis of a testnet",
+		Long: `Export the application state for a new chain starting at height zero, in the
+same way as "export --for-zero-height", and turn it into the genesis of a testnet.
+
+Each --validator is a consensus public key, as printed by "tendermint
+show-validator", taking over one of the bonded validators with the most power,
+in order. The other validators are jailed, so the given keys make up the whole
+validator set of the testnet. Each --balance mints coins to an account.
+
+Use --skip-invariants to skip the invariant assertion ahead of the export, and
+--keep-rewards to keep the outstanding rewards and commissions rather than
+withdrawing them.`,
+		Example: fmt.Sprintf(`$ %s testnet fork --chain-id grid-fork-1 \
+    --validator "$(%s tendermint show-validator --home ./node0)" \
+    --balance <address>=1000000000ufury --output-document genesis.json`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			skipInvariants, _ := cmd.Flags().GetBool(flagSkipInvariants)
+			keepRewards, _ := cmd.Flags().GetBool(flagKeepRewards)
+			validators, _ := cmd.Flags().GetStringArray(flagValidator)
+			balances, _ := cmd.Flags().GetStringArray(flagBalance)
+			outputDocument, _ := cmd.Flags().GetString(flagOutputDocument)
+
+			fork := &app.ForkOptions{}
+			var err error
+			if fork.Validators, err = parseForkValidators(ac.encCfg.Marshaler, validators); err != nil {
+				return err
+			}
+			if fork.Balances, err = parseForkBalances(balances); err != nil {
+				return err
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			gridApp, err := ac.loadApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+
+			var writer genesisWriter
+			if outputDocument != "" {
+				file, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				if writer, err = newDocGenesisWriter(file, doc); err != nil {
+					return err
+				}
+			} else if writer, err = newDocGenesisWriter(cmd.OutOrStdout(), doc); err != nil {
+				return err
+			}
+
+			exported, err := gridApp.StreamAppStateAndValidators(app.ExportOptions{
+				ForZeroHeight:  true,
+				SkipInvariants: skipInvariants,
+				KeepRewards:    keepRewards,
+				Fork:           fork,
+			}, writer.WriteModule)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+
+			doc = exportedGenesisDoc(doc, exported)
+			doc.ChainID = chainID
+			return writer.Close(doc)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of the testnet")
+	cmd.Flags().Bool(flagSkipInvariants, false, "Skip the invariant assertion ahead of the export")
+	cmd.Flags().Bool(flagKeepRewards, false, "Keep the outstanding rewards and commissions rather than withdrawing them")
+	cmd.Flags().StringArray(flagValidator, nil, "Consensus public key of a testnet validator, in JSON (repeatable)")
+	cmd.Flags().StringArray(flagBalance, nil, "Coins to mint to an account, as <address>=<coins> (repeatable)")
+	cmd.Flags().String(flagOutputDocument, "", "Write the genesis document to the given file instead of the standard output")
+	_ = cmd.MarkFlagRequired(flags.FlagChainID)
+
+	return cmd
+}
+
+// parseForkValidators decodes the JSON consensus public keys of the validators
+func parseForkValidators(cdc codec.Codec, validators []string) ([]cryptotypes.PubKey, error) {
+	pubKeys := make([]cryptotypes.PubKey, len(validators))
+	for i, validator := range validators {
+		if err := cdc.UnmarshalInterfaceJSON([]byte(validator), &pubKeys[i]); err != nil {
+			return nil, fmt.Errorf("invalid validator key %s: %w", validator, err)
+		}
+	}
+	return pubKeys, nil
+}
+
+// parseForkBalances parses <address>=<coins> balances, merging the ones of
+// the same account
+func parseForkBalances(balances []string) ([]banktypes.Balance, error) {
+	var parsed []banktypes.Balance
+	index := make(map[string]int, len(balances))
+	for _, balance := range balances {
+		parts := strings.SplitN(balance, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid balance %s, expected <address>=<coins>", balance)
+		}
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance %s: %w", balance, err)
+		}
+		coins, err := sdk.ParseCoinsNormalized(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance %s: %w", balance, err)
+		}
+		if !coins.IsAllPositive() {
+			return nil, fmt.Errorf("invalid balance %s: no coins", balance)
+		}
+
+		if i, ok := index[addr.String()]; ok {
+			parsed[i].Coins = parsed[i].Coins.Add(coins...)
+			continue
+		}
+		index[addr.String()] = len(parsed)
+		parsed = append(parsed, banktypes.Balance{Address: addr.String(), Coins: coins})
+	}
+	return parsed, nil
+}
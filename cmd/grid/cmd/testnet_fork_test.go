@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+func TestParseForkOptions(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	pk := ed25519.GenPrivKey().PubKey()
+	bz, err := cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	pubKeys, err := parseForkValidators(cdc, []string{string(bz)})
+	require.NoError(t, err)
+	require.Len(t, pubKeys, 1)
+	require.True(t, pk.Equals(pubKeys[0]))
+
+	_, err = parseForkValidators(cdc, []string{`{"@type":"/cosmos.crypto.ed25519.PubKey"`})
+	require.Error(t, err)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+	balances, err := parseForkBalances([]string{
+		addr1 + "=100ufury",
+		addr2 + "=5ufury,7uatom",
+		addr1 + "=20ufury",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []banktypes.Balance{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 120))},
+		{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("ufury", 5), sdk.NewInt64Coin("uatom", 7))},
+	}, balances)
+
+	for _, balance := range []string{addr1, "addr=100ufury", addr1 + "=", addr1 + "=100"} {
+		_, err := parseForkBalances([]string{balance})
+		require.Error(t, err, balance)
+	}
+}
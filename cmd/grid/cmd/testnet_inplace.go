@@ -0,0 +1,336 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/privval"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/furynet/furyhub/app"
+)
+
+const (
+	flagAccount      = "account"
+	flagSupers       = "supers"
+	flagVotingPeriod = "voting-period"
+
+	// genesisDocKey is the key tendermint stores the genesis document at in
+	// its state database
+	genesisDocKey = "genesisDoc"
+)
+
+// testnetInPlaceCmd rewrites the state of a node so that it runs alone as a testnet
+func testnetInPlaceCmd(ac appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-place",
+		Short: "Rewrite the state of a stopped node to run it alone as a testnet",
+		Long: `Rewrite the stored state of a stopped node, such as a mainnet node, so that it
+runs alone as a testnet with the stored state, without a genesis re-import.
+
+The node validator, from priv_validator_key.json, takes over the bonded
+validator with the most power and all the other validators are jailed. The
+--account delegates nine times the tokens of the validator to it, so it holds
+most of the voting power, and receives the --balance coins. The --supers, the
+--account by default, replace the genesis guardian supers, and the gov voting
+and max deposit periods are set to --voting-period.
+
+The changes are stored as an extra block produced by the node validator on the
+new chain-id, after which the node can be started as usual. Back up the node
+home before running this command: the change cannot be undone.`,
+		Example: fmt.Sprintf(`$ %s testnet in-place --chain-id grid-local-1 --account <address> --balance 1000000000ufury
+$ %s start`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			account, _ := cmd.Flags().GetString(flagAccount)
+			balance, _ := cmd.Flags().GetString(flagBalance)
+			supers, _ := cmd.Flags().GetStringSlice(flagSupers)
+			votingPeriod, _ := cmd.Flags().GetDuration(flagVotingPeriod)
+
+			opts := app.TestnetOptions{VotingPeriod: votingPeriod}
+			var err error
+			if opts.Delegator, err = sdk.AccAddressFromBech32(account); err != nil {
+				return fmt.Errorf("invalid account %s: %w", account, err)
+			}
+			if opts.Balance, err = sdk.ParseCoinsNormalized(balance); err != nil {
+				return fmt.Errorf("invalid balance %s: %w", balance, err)
+			}
+			if len(supers) == 0 {
+				supers = []string{account}
+			}
+			for _, super := range supers {
+				addr, err := sdk.AccAddressFromBech32(super)
+				if err != nil {
+					return fmt.Errorf("invalid super %s: %w", super, err)
+				}
+				opts.Supers = append(opts.Supers, addr)
+			}
+
+			pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+			tmPubKey, err := pv.GetPubKey()
+			if err != nil {
+				return err
+			}
+			if opts.Validator, err = cryptocodec.FromTmPubKeyInterface(tmPubKey); err != nil {
+				return err
+			}
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := store.NewBlockStore(blockStoreDB)
+
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+			})
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			height := state.LastBlockHeight
+			if state.IsEmpty() || height == 0 {
+				return errors.New("the node has not stored any block yet")
+			}
+			if blockStore.Height() != height {
+				return fmt.Errorf("block store height %d does not match state height %d, start and stop the node cleanly first", blockStore.Height(), height)
+			}
+			lastCommit := blockStore.LoadSeenCommit(height)
+			if lastCommit == nil {
+				return fmt.Errorf("no commit stored for height %d", height)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			gridApp, err := ac.loadApp(serverCtx.Logger, db, nil, -1, serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			if gridApp.LastBlockHeight() != height {
+				return fmt.Errorf("application height %d does not match state height %d", gridApp.LastBlockHeight(), height)
+			}
+
+			blockTime := tmtime.Now()
+			if !blockTime.After(state.LastBlockTime) {
+				blockTime = state.LastBlockTime.Add(time.Second)
+			}
+
+			ctx := gridApp.NewUncachedContext(false, tmproto.Header{
+				ChainID: chainID,
+				Height:  height + 1,
+				Time:    blockTime,
+			})
+			if err := gridApp.ApplyTestnet(ctx, opts); err != nil {
+				return err
+			}
+			genValidators, err := staking.WriteValidators(ctx, gridApp.StakingKeeper)
+			if err != nil {
+				return err
+			}
+			valSet, err := testnetValidatorSet(genValidators)
+			if err != nil {
+				return err
+			}
+
+			block, parts, seenCommit, err := testnetBlock(state, chainID, blockTime, lastCommit, valSet, pv)
+			if err != nil {
+				return err
+			}
+
+			// from here on the node is rewritten
+			commitID := gridApp.CommitMultiStore().Commit()
+
+			blockStore.SaveBlock(block, parts, seenCommit)
+			abciResponses := &tmstate.ABCIResponses{
+				BeginBlock: &abci.ResponseBeginBlock{},
+				EndBlock:   &abci.ResponseEndBlock{},
+			}
+			if err := stateStore.SaveABCIResponses(block.Height, abciResponses); err != nil {
+				return err
+			}
+			state = testnetState(state, block, seenCommit.BlockID, valSet, commitID.Hash, sm.ABCIResponsesResultsHash(abciResponses))
+			if err := stateStore.Bootstrap(state); err != nil {
+				return err
+			}
+			if err := setGenesisChainID(stateDB, config.GenesisFile(), chainID); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "the node now runs %s from height %d with validator %s\n",
+				chainID, block.Height+1, sdk.ConsAddress(tmPubKey.Address()))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of the testnet")
+	cmd.Flags().String(flagAccount, "", "Local account delegating most of the voting power to the node validator")
+	cmd.Flags().String(flagBalance, "", "Coins minted to the local account on top of its delegation")
+	cmd.Flags().StringSlice(flagSupers, nil, "Comma-separated list of the addresses replacing the genesis guardian supers (the local account if empty)")
+	cmd.Flags().Duration(flagVotingPeriod, time.Minute, "Gov voting and max deposit period of the testnet")
+	_ = cmd.MarkFlagRequired(flags.FlagChainID)
+	_ = cmd.MarkFlagRequired(flagAccount)
+
+	return cmd
+}
+
+// testnetValidatorSet returns the tendermint validator set of the given validators
+func testnetValidatorSet(genValidators []tmtypes.GenesisValidator) (*tmtypes.ValidatorSet, error) {
+	validators := make([]*tmtypes.Validator, len(genValidators))
+	for i, v := range genValidators {
+		validators[i] = tmtypes.NewValidator(v.PubKey, v.Power)
+	}
+	valSet := tmtypes.NewValidatorSet(validators)
+	if valSet.IsNilOrEmpty() {
+		return nil, errors.New("empty validator set")
+	}
+	return valSet, valSet.ValidateBasic()
+}
+
+// testnetBlock returns an empty block following the last block of the state
+// on the new chain-id, along with the commit of the block signed by the
+// private validator
+func testnetBlock(
+	state sm.State,
+	chainID string,
+	blockTime time.Time,
+	lastCommit *tmtypes.Commit,
+	valSet *tmtypes.ValidatorSet,
+	pv tmtypes.PrivValidator,
+) (*tmtypes.Block, *tmtypes.PartSet, *tmtypes.Commit, error) {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !valSet.HasAddress(pubKey.Address()) {
+		return nil, nil, nil, fmt.Errorf("validator %s is not in the validator set", pubKey.Address())
+	}
+
+	height := state.LastBlockHeight + 1
+	block := tmtypes.MakeBlock(height, nil, lastCommit, nil)
+	block.Header.Populate(
+		state.Version.Consensus, chainID, blockTime, state.LastBlockID,
+		valSet.Hash(), valSet.Hash(),
+		tmtypes.HashConsensusParams(state.ConsensusParams), state.AppHash, state.LastResultsHash,
+		pubKey.Address(),
+	)
+	parts := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+	blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+	sigs := make([]tmtypes.CommitSig, valSet.Size())
+	for i, val := range valSet.Validators {
+		if !bytes.Equal(val.Address, pubKey.Address()) {
+			sigs[i] = tmtypes.NewCommitSigAbsent()
+			continue
+		}
+		vote := &tmtypes.Vote{
+			Type:    tmproto.PrecommitType,
+			Height:  height,
+			Round:   0,
+			BlockID: blockID,
+			// the time of the next block is the median time of this commit,
+			// which has to be after the block time
+			Timestamp:        blockTime.Add(time.Millisecond),
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+		v := vote.ToProto()
+		if err := pv.SignVote(chainID, v); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to sign the testnet block: %w", err)
+		}
+		sigs[i] = tmtypes.NewCommitSigForBlock(v.Signature, val.Address, v.Timestamp)
+	}
+	commit := tmtypes.NewCommit(height, 0, blockID, sigs)
+	if err := valSet.VerifyCommit(chainID, blockID, height, commit); err != nil {
+		return nil, nil, nil, err
+	}
+	return block, parts, commit, nil
+}
+
+// testnetState returns the state following the testnet block
+func testnetState(
+	state sm.State,
+	block *tmtypes.Block,
+	blockID tmtypes.BlockID,
+	valSet *tmtypes.ValidatorSet,
+	appHash, resultsHash []byte,
+) sm.State {
+	state.ChainID = block.ChainID
+	state.LastBlockHeight = block.Height
+	state.LastBlockID = blockID
+	state.LastBlockTime = block.Time
+	state.LastValidators = valSet.Copy()
+	state.Validators = valSet.CopyIncrementProposerPriority(1)
+	state.NextValidators = valSet.CopyIncrementProposerPriority(2)
+	state.LastHeightValidatorsChanged = block.Height + 1
+	state.AppHash = appHash
+	state.LastResultsHash = resultsHash
+	return state
+}
+
+// setGenesisChainID sets the chain-id of the genesis document stored by
+// tendermint and of the genesis file
+func setGenesisChainID(stateDB dbm.DB, genesisFile, chainID string) error {
+	bz, err := stateDB.Get([]byte(genesisDocKey))
+	if err != nil {
+		return err
+	}
+	if len(bz) > 0 {
+		var doc tmtypes.GenesisDoc
+		if err := tmjson.Unmarshal(bz, &doc); err != nil {
+			return err
+		}
+		doc.ChainID = chainID
+		if bz, err = tmjson.Marshal(&doc); err != nil {
+			return err
+		}
+		if err := stateDB.SetSync([]byte(genesisDocKey), bz); err != nil {
+			return err
+		}
+	}
+
+	doc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return err
+	}
+	doc.ChainID = chainID
+	return doc.SaveAs(genesisFile)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	sm "github.com/tendermint/tendermint/state"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+)
+
+func TestTestnetBlock(t *testing.T) {
+	pv := tmtypes.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	valSet, err := testnetValidatorSet([]tmtypes.GenesisValidator{{PubKey: pubKey, Power: 10}})
+	require.NoError(t, err)
+
+	_, err = testnetValidatorSet(nil)
+	require.Error(t, err)
+
+	lastBlockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := sm.State{
+		Version:         tmstate.Version{Consensus: tmversion.Consensus{Block: version.BlockProtocol}},
+		ChainID:         "grid-main",
+		LastBlockHeight: 5,
+		LastBlockID:     tmtypes.BlockID{Hash: make([]byte, 32), PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: make([]byte, 32)}},
+		LastBlockTime:   lastBlockTime,
+		ConsensusParams: *tmtypes.DefaultConsensusParams(),
+		AppHash:         []byte("app_hash"),
+	}
+	lastCommit := tmtypes.NewCommit(5, 0, state.LastBlockID, []tmtypes.CommitSig{tmtypes.NewCommitSigAbsent()})
+
+	blockTime := lastBlockTime.Add(time.Hour)
+	block, parts, commit, err := testnetBlock(state, "grid-local-1", blockTime, lastCommit, valSet, pv)
+	require.NoError(t, err)
+	require.NoError(t, block.ValidateBasic())
+	require.Equal(t, int64(6), block.Height)
+	require.Equal(t, "grid-local-1", block.ChainID)
+	require.Equal(t, []byte(pubKey.Address()), []byte(block.ProposerAddress))
+	require.True(t, parts.IsComplete())
+
+	blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	require.NoError(t, valSet.VerifyCommit("grid-local-1", blockID, 6, commit))
+	// the next block time must be after the testnet block time
+	require.True(t, commit.Signatures[0].Timestamp.After(blockTime))
+
+	// the block has to be signed by the validator set
+	_, _, _, err = testnetBlock(state, "grid-local-1", blockTime, lastCommit, valSet, tmtypes.NewMockPV())
+	require.Error(t, err)
+
+	state = testnetState(state, block, commit.BlockID, valSet, []byte("new_app_hash"), nil)
+	require.Equal(t, "grid-local-1", state.ChainID)
+	require.Equal(t, int64(6), state.LastBlockHeight)
+	require.Equal(t, blockID, state.LastBlockID)
+	require.Equal(t, blockTime, state.LastBlockTime)
+	require.Equal(t, int64(7), state.LastHeightValidatorsChanged)
+	require.Equal(t, valSet.Hash(), state.Validators.Hash())
+	require.Equal(t, valSet.Hash(), state.NextValidators.Hash())
+	require.Equal(t, []byte("new_app_hash"), state.AppHash)
+}
@@ -0,0 +1,373 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+const (
+	// tokenCacheFile is the token metadata cache, under the config directory of the client home
+	tokenCacheFile = "tokens.json"
+
+	flagTokenMetadata = "token-metadata"
+)
+
+// tokenMetadata is what the coin converter needs to know about a token
+type tokenMetadata struct {
+	Symbol  string `json:"symbol"`
+	MinUnit string `json:"min_unit"`
+	Scale   uint32 `json:"scale"`
+}
+
+// token returns the token converting the coins of the metadata
+func (m tokenMetadata) token() tokentypes.TokenI {
+	return &tokentypes.Token{Symbol: m.Symbol, MinUnit: m.MinUnit, Scale: m.Scale}
+}
+
+// tokenCache is the local copy of the token metadata of a chain, looked up by
+// symbol or min unit
+type tokenCache struct {
+	path    string
+	ChainID string          `json:"chain_id"`
+	Tokens  []tokenMetadata `json:"tokens"`
+}
+
+// tokenCachePath returns the path of the token cache of the client home
+func tokenCachePath(home string) string {
+	return filepath.Join(home, "config", tokenCacheFile)
+}
+
+// loadTokenCache reads the token cache, a missing file is an empty cache
+func loadTokenCache(path string) (*tokenCache, error) {
+	cache := &tokenCache{path: path}
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return cache, err
+	}
+	if err := json.Unmarshal(bz, cache); err != nil {
+		return &tokenCache{path: path}, fmt.Errorf("invalid token cache %s: %w", path, err)
+	}
+	return cache, nil
+}
+
+// loadPinnedTokens reads a token metadata file that must exist and belong to
+// the given chain, if any
+func loadPinnedTokens(path, chainID string) (*tokenCache, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("invalid token metadata file: %w", err)
+	}
+	cache, err := loadTokenCache(path)
+	if err != nil {
+		return nil, err
+	}
+	if cache.ChainID != "" && chainID != "" && cache.ChainID != chainID {
+		return nil, fmt.Errorf("token metadata file %s belongs to chain %s, not %s", path, cache.ChainID, chainID)
+	}
+	return cache, nil
+}
+
+// get returns the metadata of the token with the given symbol or min unit
+func (c *tokenCache) get(denom string) (tokenMetadata, bool) {
+	for _, metadata := range c.Tokens {
+		if metadata.Symbol == denom || metadata.MinUnit == denom {
+			return metadata, true
+		}
+	}
+	return tokenMetadata{}, false
+}
+
+// put adds or replaces the metadata of a token
+func (c *tokenCache) put(metadata tokenMetadata) {
+	for i, cached := range c.Tokens {
+		if cached.MinUnit == metadata.MinUnit {
+			c.Tokens[i] = metadata
+			return
+		}
+	}
+	c.Tokens = append(c.Tokens, metadata)
+	sort.Slice(c.Tokens, func(i, j int) bool { return c.Tokens[i].Symbol < c.Tokens[j].Symbol })
+}
+
+// save writes the token cache, replacing the previous file at once
+func (c *tokenCache) save() error {
+	bz, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, append(bz, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// queryTokenMetadata queries the metadata of a token from the token module,
+// falling back to the denom metadata of the bank module
+func queryTokenMetadata(clientCtx client.Context, denom string) (tokenMetadata, error) {
+	if err := tokentypes.ValidateSymbol(denom); err == nil {
+		res, err := tokentypes.NewQueryClient(clientCtx).Token(context.Background(), &tokentypes.QueryTokenRequest{
+			Denom: denom,
+		})
+		if err == nil {
+			var token tokentypes.TokenI
+			if err := clientCtx.InterfaceRegistry.UnpackAny(res.Token, &token); err != nil {
+				return tokenMetadata{}, err
+			}
+			return tokenMetadataOf(token), nil
+		}
+	}
+
+	res, err := banktypes.NewQueryClient(clientCtx).DenomMetadata(context.Background(), &banktypes.QueryDenomMetadataRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return tokenMetadata{}, err
+	}
+	metadata, ok := bankTokenMetadata(res.Metadata)
+	if !ok {
+		return tokenMetadata{}, fmt.Errorf("denom %s has no display unit", denom)
+	}
+	return metadata, nil
+}
+
+func tokenMetadataOf(token tokentypes.TokenI) tokenMetadata {
+	return tokenMetadata{
+		Symbol:  token.GetSymbol(),
+		MinUnit: token.GetMinUnit(),
+		Scale:   token.GetScale(),
+	}
+}
+
+// bankTokenMetadata returns the token metadata of a bank denom, whose symbol
+// is the display unit
+func bankTokenMetadata(metadata banktypes.Metadata) (tokenMetadata, bool) {
+	if metadata.Display == "" || metadata.Display == metadata.Base {
+		return tokenMetadata{}, false
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return tokenMetadata{Symbol: metadata.Display, MinUnit: metadata.Base, Scale: unit.Exponent}, true
+		}
+	}
+	return tokenMetadata{}, false
+}
+
+// tokensCommand returns the commands managing the token metadata cache
+func tokensCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "tokens",
+		Short:                      "Manage the token metadata cache used to convert coin amounts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(tokensSyncCmd())
+	return cmd
+}
+
+// tokensSyncCmd refreshes the token metadata cache from a node
+func tokensSyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Refresh the token metadata cache from a node",
+		Long: fmt.Sprintf(`Query the symbol, min unit and scale of all the tokens of the token module,
+and of the bank denoms with a display unit, and write them to
+<home>/config/%s.
+
+The coin amounts given in main unit, such as 1.5fury, are converted with the
+cache first, so that transactions can be generated offline.`, tokenCacheFile),
+		Example: fmt.Sprintf("$ %s tokens sync --node tcp://localhost:26657", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID := clientCtx.ChainID
+			if chainID == "" {
+				node, err := clientCtx.GetNode()
+				if err != nil {
+					return err
+				}
+				status, err := node.Status(context.Background())
+				if err != nil {
+					return err
+				}
+				chainID = status.NodeInfo.Network
+			}
+			cache := &tokenCache{path: tokenCachePath(clientCtx.HomeDir), ChainID: chainID}
+
+			bankMetadata, err := queryAllDenomsMetadata(clientCtx)
+			if err != nil {
+				return err
+			}
+			for _, metadata := range bankMetadata {
+				if token, ok := bankTokenMetadata(metadata); ok {
+					cache.put(token)
+				}
+			}
+			// the tokens of the token module prevail over the bank metadata
+			tokens, err := queryAllTokens(clientCtx)
+			if err != nil {
+				return err
+			}
+			for _, token := range tokens {
+				cache.put(tokenMetadataOf(token))
+			}
+
+			if err := cache.save(); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%d tokens written to %s\n", len(cache.Tokens), cache.path)
+			return nil
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func queryAllTokens(clientCtx client.Context) ([]tokentypes.TokenI, error) {
+	queryClient := tokentypes.NewQueryClient(clientCtx)
+	var tokens []tokentypes.TokenI
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.Tokens(context.Background(), &tokentypes.QueryTokensRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		for _, tokenAny := range res.Tokens {
+			var token tokentypes.TokenI
+			if err := clientCtx.InterfaceRegistry.UnpackAny(tokenAny, &token); err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return tokens, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+func queryAllDenomsMetadata(clientCtx client.Context) ([]banktypes.Metadata, error) {
+	queryClient := banktypes.NewQueryClient(clientCtx)
+	var metadata []banktypes.Metadata
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.DenomsMetadata(context.Background(), &banktypes.QueryDenomsMetadataRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		metadata = append(metadata, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return metadata, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// reviewTx prints the amounts of the transaction file to sign, in min and
+// main unit, for the signer to review them
+func (it *coinConverter) reviewTx(cmd *cobra.Command, path string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.ErrOrStderr()
+	fmt.Fprintf(w, "Amounts of the transaction, converted with %s:\n", it.pinned.path)
+	review := func(name string, coin sdk.Coin) {
+		main := "unknown token"
+		if mainCoin, err := it.convertToMainCoin(cmd, coin); err == nil {
+			main = formatMainCoin(mainCoin)
+		}
+		fmt.Fprintf(w, "  %s: %s (%s)\n", name, coin, main)
+	}
+	for _, msg := range tx.GetMsgs() {
+		walkCoins(reflect.ValueOf(msg), sdk.MsgTypeURL(msg), review)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		for _, coin := range feeTx.GetFee() {
+			review("fee", coin)
+		}
+	}
+	return nil
+}
+
+// walkCoins calls fn with the path and value of each sdk.Coin of the value
+func walkCoins(v reflect.Value, path string, fn func(path string, coin sdk.Coin)) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if any, ok := v.Interface().(*codectypes.Any); ok {
+			if cached := any.GetCachedValue(); cached != nil {
+				walkCoins(reflect.ValueOf(cached), path, fn)
+			}
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == coinType {
+			fn(path, v.Interface().(sdk.Coin))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			structField := v.Type().Field(i)
+			if _, ok := structField.Tag.Lookup("protobuf_oneof"); ok {
+				walkCoins(v.Field(i), path, fn)
+				continue
+			}
+			if name := protoFieldName(structField.Tag.Get("protobuf")); name != "" {
+				walkCoins(v.Field(i), path+"."+name, fn)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walkCoins(v.Index(i), fmt.Sprintf("%s.%d", path, i), fn)
+		}
+	}
+}
+
+// formatMainCoin formats a main unit coin without its trailing zeros
+func formatMainCoin(coin sdk.DecCoin) string {
+	amount := strings.TrimRight(coin.Amount.String(), "0")
+	return strings.TrimSuffix(amount, ".") + coin.Denom
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+func TestTokenCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", tokenCacheFile)
+
+	cache, err := loadTokenCache(path)
+	require.NoError(t, err)
+	require.Empty(t, cache.Tokens)
+
+	cache.ChainID = "grid-1"
+	cache.put(tokenMetadata{Symbol: "fury", MinUnit: "ufury", Scale: 6})
+	cache.put(tokenMetadata{Symbol: "atom", MinUnit: "uatom", Scale: 5})
+	cache.put(tokenMetadata{Symbol: "atom", MinUnit: "uatom", Scale: 6})
+	require.NoError(t, cache.save())
+
+	loaded, err := loadTokenCache(path)
+	require.NoError(t, err)
+	require.Equal(t, "grid-1", loaded.ChainID)
+	require.Equal(t, []tokenMetadata{
+		{Symbol: "atom", MinUnit: "uatom", Scale: 6},
+		{Symbol: "fury", MinUnit: "ufury", Scale: 6},
+	}, loaded.Tokens)
+
+	for _, denom := range []string{"fury", "ufury"} {
+		metadata, ok := loaded.get(denom)
+		require.True(t, ok, denom)
+		require.Equal(t, "ufury", metadata.MinUnit)
+	}
+	_, ok := loaded.get("uiris")
+	require.False(t, ok)
+}
+
+func TestBankTokenMetadata(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3},
+			{Denom: "atom", Exponent: 6},
+		},
+	}
+	token, ok := bankTokenMetadata(metadata)
+	require.True(t, ok)
+	require.Equal(t, tokenMetadata{Symbol: "atom", MinUnit: "uatom", Scale: 6}, token)
+
+	metadata.Display = "uatom"
+	_, ok = bankTokenMetadata(metadata)
+	require.False(t, ok)
+	metadata.Display = "natom"
+	_, ok = bankTokenMetadata(metadata)
+	require.False(t, ok)
+}
+
+func TestConvertCoinsOffline(t *testing.T) {
+	home := t.TempDir()
+	cache := &tokenCache{path: tokenCachePath(home), ChainID: "grid-1"}
+	cache.put(tokenMetadata{Symbol: "fury", MinUnit: "ufury", Scale: 6})
+	require.NoError(t, cache.save())
+
+	newCmd := func(chainID string) *cobra.Command {
+		cmd := &cobra.Command{}
+		flags.AddTxFlagsToCmd(cmd)
+		require.NoError(t, cmd.Flags().Set(flags.FlagGenerateOnly, "true"))
+		clientCtx := client.Context{}.WithHomeDir(home).WithChainID(chainID)
+		cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+		return cmd
+	}
+
+	// the node is never queried
+	coins, err := NewConverter().convertCoins(newCmd("grid-1"), "1.5fury")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ufury", 1500000).String(), coins)
+
+	// the cache of another chain is not used
+	_, err = NewConverter().convertCoins(newCmd("grid-2"), "1.5fury")
+	require.ErrorContains(t, err, "tokens sync")
+}
+
+func TestConvertCoinsPinned(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pinned.json")
+	pinned := &tokenCache{path: path, ChainID: "grid-1"}
+	pinned.put(tokenMetadata{Symbol: "fury", MinUnit: "ufury", Scale: 6})
+	require.NoError(t, pinned.save())
+
+	_, err := loadPinnedTokens(path, "grid-2")
+	require.ErrorContains(t, err, "belongs to chain grid-1")
+	_, err = loadPinnedTokens(filepath.Join(t.TempDir(), "missing.json"), "grid-1")
+	require.Error(t, err)
+
+	it := NewConverter()
+	it.pinned, err = loadPinnedTokens(path, "grid-1")
+	require.NoError(t, err)
+	cmd := testOutputCmd("text")
+
+	coins, err := it.convertCoins(cmd, "1.000001fury")
+	require.NoError(t, err)
+	require.Equal(t, "1000001ufury", coins)
+
+	// the integer amounts of the other denoms are kept
+	coins, err = it.convertCoins(cmd, "5"+ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, "5"+ibcDenom, coins)
+
+	_, err = it.convertCoins(cmd, "1.0000001fury")
+	require.ErrorIs(t, err, errInexactAmount)
+	_, err = it.convertCoins(cmd, "1.5ufury")
+	require.ErrorIs(t, err, errInexactAmount)
+	_, err = it.convertCoins(cmd, "1.5atom")
+	require.ErrorContains(t, err, "not found in the pinned token metadata")
+
+	require.Error(t, it.conversionError("amount", err))
+	require.NoError(t, it.conversionError("amount", errNotCoins))
+	it.pinned = nil
+	require.NoError(t, it.conversionError("amount", err))
+}
+
+func TestReviewTx(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	addr := sdk.AccAddress([]byte("treasury____________"))
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(
+		sdk.NewInt64Coin("ufury", 1500000), sdk.NewInt64Coin(ibcDenom, 5),
+	))))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ufury", 2000)))
+	bz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	txPath := filepath.Join(t.TempDir(), "unsigned.json")
+	require.NoError(t, ioutil.WriteFile(txPath, bz, 0o600))
+
+	it := NewConverter()
+	it.pinned = &tokenCache{path: "pinned.json"}
+	it.pinned.put(tokenMetadata{Symbol: "fury", MinUnit: "ufury", Scale: 6})
+
+	cmd := &cobra.Command{}
+	clientCtx := client.Context{}.WithTxConfig(encCfg.TxConfig)
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	out := &bytes.Buffer{}
+	cmd.SetErr(out)
+
+	require.NoError(t, it.reviewTx(cmd, txPath))
+	require.Equal(t, `Amounts of the transaction, converted with pinned.json:
+  /cosmos.bank.v1beta1.MsgSend.amount.0: 5`+ibcDenom+` (unknown token)
+  /cosmos.bank.v1beta1.MsgSend.amount.1: 1500000ufury (1.5fury)
+  fee: 2000ufury (0.002fury)
+`, out.String())
+}
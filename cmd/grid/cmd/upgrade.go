@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/furynet/furyhub/app"
+	"github.com/furynet/furyhub/app/upgrades"
+)
+
+// upgradeCommand returns the software upgrade utilities
+func upgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "upgrade",
+		Short:                      "Software upgrade utilities",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		upgradeDryRunCmd(),
+		upgradeTIBCClientCmd(),
+		upgradeValidateInfoCmd(),
+	)
+	return cmd
+}
+
+// upgradeDryRunCmd runs an upgrade handler against a copy of the local state
+func upgradeDryRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [name]",
+		Short: "Run an upgrade handler against a copy of the local state without committing it",
+		Long: fmt.Sprintf(`Copy the application state of the node home, apply the store upgrades and
+run the handler of the named upgrade in a cache context on top of the latest
+height, then report the module version changes, the store additions and any
+error. The node state is left untouched, stop the node before running it.
+
+Known upgrades: %s`, strings.Join(upgrades.Names(), ", ")),
+		Example: fmt.Sprintf("$ %s upgrade dry-run v1.4 --home ~/.grid", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			u, err := upgrades.Get(args[0])
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			tmpDir, err := os.MkdirTemp("", "grid-upgrade-dry-run")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+
+			appDB := filepath.Join(serverCtx.Config.DBDir(), "application.db")
+			if _, err := os.Stat(appDB); err != nil {
+				return fmt.Errorf("no application state found in %s: %w", home, err)
+			}
+			if err := copyDir(appDB, filepath.Join(tmpDir, "application.db")); err != nil {
+				return fmt.Errorf("failed to copy the application state: %w", err)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), tmpDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			gridApp := app.NewGridApp(
+				serverCtx.Logger, db, nil, false, map[int64]bool{}, home, 0,
+				app.MakeEncodingConfig(), serverCtx.Viper,
+			)
+			if err := gridApp.LoadLatestVersionWithUpgrade(u); err != nil {
+				return err
+			}
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			header := tmproto.Header{
+				ChainID: chainID,
+				Height:  gridApp.LastBlockHeight() + 1,
+				Time:    time.Now().UTC(),
+			}
+			var info string
+			if infoFile, _ := cmd.Flags().GetString(flagInfoFile); infoFile != "" {
+				bz, err := os.ReadFile(infoFile)
+				if err != nil {
+					return err
+				}
+				info = string(bz)
+			}
+
+			report, runErr := gridApp.DryRunUpgrade(u, header, info)
+			printUpgradeReport(cmd.OutOrStdout(), report)
+			return runErr
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID set in the context of the upgrade handler")
+	cmd.Flags().String(flagInfoFile, "", "File holding the info of the upgrade plan")
+	return cmd
+}
+
+func printUpgradeReport(out io.Writer, report app.UpgradeReport) {
+	fmt.Fprintf(out, "upgrade %s at height %d\n", report.Name, report.Height)
+
+	fmt.Fprintln(out, "store upgrades:")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "  added:\t%s\n", strings.Join(report.StoreUpgrades.Added, ", "))
+	renamed := make([]string, len(report.StoreUpgrades.Renamed))
+	for i, r := range report.StoreUpgrades.Renamed {
+		renamed[i] = fmt.Sprintf("%s -> %s", r.OldKey, r.NewKey)
+	}
+	fmt.Fprintf(w, "  renamed:\t%s\n", strings.Join(renamed, ", "))
+	fmt.Fprintf(w, "  deleted:\t%s\n", strings.Join(report.StoreUpgrades.Deleted, ", "))
+	_ = w.Flush()
+
+	if report.ToVersions == nil {
+		return
+	}
+
+	names := make(map[string]bool)
+	for _, vm := range []map[string]uint64{report.FromVersions, report.ToVersions, report.ExpectedVersions} {
+		for name := range vm {
+			names[name] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	fmt.Fprintln(out, "module versions:")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  MODULE\tFROM\tTO\tEXPECTED\t")
+	for _, name := range sorted {
+		from, hasFrom := report.FromVersions[name]
+		to, hasTo := report.ToVersions[name]
+		expected, hasExpected := report.ExpectedVersions[name]
+
+		var notes []string
+		switch {
+		case !hasFrom && hasTo:
+			notes = append(notes, "added")
+		case hasFrom && hasTo && from != to:
+			notes = append(notes, "migrated")
+		}
+		if hasExpected && to != expected {
+			notes = append(notes, "version mismatch")
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n",
+			name,
+			formatVersion(from, hasFrom),
+			formatVersion(to, hasTo),
+			formatVersion(expected, hasExpected),
+			strings.Join(notes, ", "),
+		)
+	}
+	_ = w.Flush()
+}
+
+func formatVersion(version uint64, found bool) string {
+	if !found {
+		return "-"
+	}
+	return fmt.Sprintf("%d", version)
+}
+
+// copyDir recursively copies the regular files of the src directory to dst
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
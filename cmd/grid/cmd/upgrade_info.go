@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/furynet/furyhub/app"
+	migratetibc "github.com/furynet/furyhub/migrate/tibc"
+)
+
+const (
+	flagRelayers = "relayers"
+	flagInfoFile = "info-file"
+)
+
+// upgradeTIBCClientCmd adds a TIBC client definition to an upgrade plan info
+func upgradeTIBCClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-tibc-client [chain-name] [client-state-file] [consensus-state-file]",
+		Short: "Add a TIBC client to the info of an upgrade plan",
+		Long: fmt.Sprintf(`Build the info of an upgrade plan creating a TIBC client once the upgrade
+handler has run. The client and consensus states are JSON encoded Any, with
+their "@type". The client is validated and appended to the "%s" of the
+plan info read from --info-file, if any, and the resulting info is printed.
+
+Submit the info along with the software upgrade proposal, e.g. with
+--upgrade-info "$(cat info.json)".`, migratetibc.PlanInfoKey),
+		Example: fmt.Sprintf(`$ %s upgrade add-tibc-client bsc-mainnet client_state.json consensus_state.json --relayers <address> > info.json
+$ %s upgrade add-tibc-client eth-mainnet eth_client.json eth_consensus.json --relayers <address> --info-file info.json`,
+			version.AppName, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			relayers, _ := cmd.Flags().GetStringSlice(flagRelayers)
+			infoFile, _ := cmd.Flags().GetString(flagInfoFile)
+
+			clientState, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			consensusState, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			info := make(map[string]json.RawMessage)
+			if infoFile != "" {
+				bz, err := os.ReadFile(infoFile)
+				if err != nil {
+					return err
+				}
+				if err := json.Unmarshal(bz, &info); err != nil {
+					return fmt.Errorf("plan info must be a JSON object: %w", err)
+				}
+			}
+
+			var datas []migratetibc.ClientData
+			if bz, ok := info[migratetibc.PlanInfoKey]; ok {
+				if err := json.Unmarshal(bz, &datas); err != nil {
+					return fmt.Errorf("invalid tibc client definitions: %w", err)
+				}
+			}
+			datas = append(datas, migratetibc.ClientData{
+				ChainName:      args[0],
+				ClientState:    clientState,
+				ConsensusState: consensusState,
+				Relayers:       relayers,
+			})
+
+			bz, err := json.Marshal(datas)
+			if err != nil {
+				return err
+			}
+			info[migratetibc.PlanInfoKey] = bz
+
+			bz, err = json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				return err
+			}
+			if _, err := validatePlanInfo(app.MakeEncodingConfig().Marshaler, string(bz)); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice(flagRelayers, nil, "Comma-separated list of the relayer addresses of the client")
+	cmd.Flags().String(flagInfoFile, "", "Plan info to add the client to")
+	_ = cmd.MarkFlagRequired(flagRelayers)
+	return cmd
+}
+
+// upgradeValidateInfoCmd validates the info of an upgrade plan
+func upgradeValidateInfoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "validate-info [info-file]",
+		Short:   "Validate the TIBC clients defined in the info of an upgrade plan",
+		Example: fmt.Sprintf("$ %s upgrade validate-info info.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			clients, err := validatePlanInfo(app.MakeEncodingConfig().Marshaler, string(bz))
+			if err != nil {
+				return err
+			}
+			if len(clients) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "the plan info defines no tibc client")
+				return nil
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "the plan info defines %d tibc client(s): %s\n", len(clients), strings.Join(clients, ", "))
+			return nil
+		},
+	}
+}
+
+// validatePlanInfo validates the info of an upgrade plan and returns the
+// chain names of the TIBC clients it defines
+func validatePlanInfo(cdc codec.Codec, info string) ([]string, error) {
+	clients, err := migratetibc.PlanInfoClients(cdc, info)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(clients))
+	for i, client := range clients {
+		names[i] = client.ChainName
+	}
+	return names, nil
+}
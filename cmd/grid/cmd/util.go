@@ -1,23 +1,28 @@
 package cmd
 
 import (
-	"context"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"os"
+	"io"
+	"reflect"
 	"strings"
 
-	"github.com/olebedev/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc/encoding"
+	"sigs.k8s.io/yaml"
 
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
 )
@@ -25,8 +30,6 @@ import (
 const (
 	formatJSON     = "json"
 	cmdScopeGlobal = "global"
-	filedTypeMap   = "map"
-	filedTypeArray = "array"
 )
 
 var (
@@ -41,25 +44,19 @@ var (
 			registerCmdWithArgs("staking", "unbond", 1).
 			registerCmdWithArgs("distribution", "fund-community-pool", 0).
 			registerCmdWithArgs("gov", "deposit", 1).
-			registerCmdWithArgs("ibc-transfer", "transfer", 3).
-			registerCmdForResponse("bank", "balances", "balances", filedTypeArray).
-			registerCmdForResponse("bank", "total", "supply", filedTypeArray).
-			registerCmdForResponse("gov", "params", "deposit_params.min_deposit", filedTypeArray).
-			registerCmdForResponse("distribution", "validator-outstanding-rewards", "rewards", filedTypeArray).
-			registerCmdForResponses("distribution", "rewards",
-			field{name: "total", typ: filedTypeArray},
-			field{name: "rewards.*.reward", typ: filedTypeArray},
-			field{name: "rewards", typ: filedTypeArray}).
-		registerCmdForResponse("token", "total-burn", "burned_coins", filedTypeArray)
-
-	rescueStdout = os.Stdout
+			registerCmdWithArgs("ibc-transfer", "transfer", 3)
+
+	errNotCoins      = errors.New("not coins")
+	errInexactAmount = errors.New("inexact amount")
+
+	coinType    = reflect.TypeOf(sdk.Coin{})
+	decCoinType = reflect.TypeOf(sdk.DecCoin{})
 )
 
 type (
 	field struct {
 		name  string
 		index int
-		typ   string
 	}
 
 	command struct {
@@ -68,11 +65,10 @@ type (
 	}
 )
 
-func (c command) append(name, typ string, index int) command {
+func (c command) append(name string, index int) command {
 	c.fields[name] = field{
 		name:  name,
 		index: index,
-		typ:   typ,
 	}
 	return c
 }
@@ -80,7 +76,11 @@ func (c command) append(name, typ string, index int) command {
 type coinConverter struct {
 	cmds   map[string]command
 	tokens map[string]tokentypes.TokenI
-	r, w   *os.File
+	cache  *tokenCache
+	// pinned is the token metadata file the conversions are limited to
+	pinned *tokenCache
+
+	queried *responseCodec
 }
 
 // NewConverter return a instance of coinConverter
@@ -103,7 +103,7 @@ func (it *coinConverter) registerCmdWithArgs(parentCmd, cmd string, argsIdx int)
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append("ARGS", "", argsIdx)
+	commands = commands.append("ARGS", argsIdx)
 	it.cmds[cmd] = commands
 	return it
 }
@@ -116,7 +116,7 @@ func (it *coinConverter) registerGlobalFlag(flagNm string) *coinConverter {
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append(flagNm, "", -1)
+	commands = commands.append(flagNm, -1)
 	it.cmds[cmdScopeGlobal] = commands
 	return it
 }
@@ -129,39 +129,7 @@ func (it *coinConverter) registerCmdWithFlag(parentCmd, cmd, flagNm string) *coi
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append(flagNm, "", -1)
-	it.cmds[cmd] = commands
-	return it
-}
-
-func (it *coinConverter) registerCmdForResponse(parentCmd, cmd, jsonPath, typ string) *coinConverter {
-	commands, ok := it.cmds[cmd]
-	if !ok {
-		commands = command{
-			parentCmd: parentCmd,
-			fields:    map[string]field{},
-		}
-	}
-
-	commands = commands.append(jsonPath, typ, -1)
-
-	it.cmds[cmd] = commands
-	return it
-}
-
-func (it *coinConverter) registerCmdForResponses(parentCmd, cmd string, fields ...field) *coinConverter {
-	commands, ok := it.cmds[cmd]
-	if !ok {
-		commands = command{
-			parentCmd: parentCmd,
-			fields:    map[string]field{},
-		}
-	}
-
-	for _, field := range fields {
-		commands = commands.append(field.name, field.typ, -1)
-	}
-
+	commands = commands.append(flagNm, -1)
 	it.cmds[cmd] = commands
 	return it
 }
@@ -189,203 +157,392 @@ func (it coinConverter) getFromArgs(cmdNm string) (field, bool) {
 	return cmd.fields["ARGS"], true
 }
 
-func (it coinConverter) getFields(cmdNm string) map[string]field {
-	cmd, ok := it.cmds[cmdNm]
-	if !ok {
-		return map[string]field{}
+func (it *coinConverter) handlePreRun(cmd *cobra.Command, args []string) error {
+	if path, _ := cmd.Flags().GetString(flagTokenMetadata); path != "" {
+		pinned, err := loadPinnedTokens(path, client.GetClientContextFromCmd(cmd).ChainID)
+		if err != nil {
+			return err
+		}
+		it.pinned = pinned
 	}
-	return cmd.fields
-}
 
-func (it *coinConverter) handlePreRun(cmd *cobra.Command, args []string) {
-	if b, _ := cmd.Flags().GetBool(flags.FlagGenerateOnly); b {
-		return
-	}
 	cmdNm := cmd.Name()
 	//handle flag
+	var err error
 	cmd.Flags().Visit(func(flag *pflag.Flag) {
 		if flag.Changed {
 			viper.SetDefault(flag.Name, flag.Value)
 		}
-		it.parseFlags(cmd, flag, cmdNm)
+		if flagErr := it.parseFlags(cmd, flag, cmdNm); flagErr != nil && err == nil {
+			err = flagErr
+		}
 	})
+	if err != nil {
+		return err
+	}
 
 	//handle field
-	it.parseArgs(cmd, args[:])
+	if err := it.parseArgs(cmd, args[:]); err != nil {
+		return err
+	}
 
-	if !it.isOutputYAML(cmd) {
-		return
+	if it.pinned != nil && it.isSignCmd(cmd) && len(args) > 0 {
+		return it.reviewTx(cmd, args[0])
 	}
-	it.r, it.w, _ = os.Pipe()
-	os.Stdout = it.w
-}
 
-func (it *coinConverter) handlePostRun(cmd *cobra.Command) {
-	if !it.isOutputYAML(cmd) {
-		return
+	if !it.isQueryCmd(cmd) {
+		return nil
 	}
-	if it.w != nil {
-		_ = it.w.Close()
+	// the query responses give the schema of the output
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	cdc, ok := clientCtx.Codec.(codec.GRPCCodecProvider)
+	if !ok {
+		return nil
 	}
-	out, _ := ioutil.ReadAll(it.r)
-	os.Stdout = rescueStdout
-	fmt.Println(it.parseYAML(cmd, out))
-}
-
-func (it coinConverter) parseFlags(cmd *cobra.Command, flag *pflag.Flag, cmdNm string) {
-	if it.hasFromFlag(cmdNm, flag.Name) {
-		srcCoinStr := flag.Value.String()
-		if res, err := it.convertCoins(cmd, srcCoinStr); err == nil {
-			_ = flag.Value.Set(res)
-		}
+	it.queried = &responseCodec{Codec: clientCtx.Codec, grpc: cdc.GRPCCodec()}
+	out := clientCtx.Output
+	if out == nil {
+		out = cmd.OutOrStdout()
 	}
+	return client.SetCmdClientContext(cmd, clientCtx.
+		WithCodec(it.queried).
+		WithOutput(&outputConverter{converter: it, cmd: cmd, out: out}))
 }
 
-func (it coinConverter) parseArgs(cmd *cobra.Command, args []string) {
-	command, ok := it.cmds[cmd.Name()]
-	if !ok {
-		return
+// convertOutput converts the coins of the printed response to their main
+// unit, the output printed after several queries is returned as is
+func (it *coinConverter) convertOutput(cmd *cobra.Command, out []byte) []byte {
+	if it.queried == nil {
+		return out
 	}
-
-	if cmd.Parent().Name() != command.parentCmd {
-		return
+	responses := it.queried.take()
+	if len(responses) != 1 || len(bytes.TrimSpace(out)) == 0 {
+		return out
 	}
 
-	if field, ok := it.getFromArgs(cmd.Name()); ok && len(args) > 0 {
-		res, err := it.convertCoins(cmd, args[field.index])
-		if err != nil {
-			return
+	isJSON := it.isOutputJSON(cmd)
+	bz := out
+	if !isJSON {
+		var err error
+		if bz, err = yaml.YAMLToJSON(out); err != nil {
+			return out
 		}
-		args[field.index] = res
 	}
-}
 
-func (it coinConverter) parseYAML(cmd *cobra.Command, in []byte) string {
-	cfg, err := config.ParseYamlBytes(in)
-	if err != nil {
-		return string(in)
+	var node interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&node); err != nil {
+		return out
+	}
+	node, converted := it.convertValue(cmd, reflect.ValueOf(responses[0]), node)
+	if !converted {
+		return out
 	}
 
-	fields := it.getFields(cmd.Name())
-	for path, field := range fields {
-		for _, p := range it.resolvePath(cfg, path) {
-			switch field.typ {
-			case filedTypeArray:
-				it.handleList(cmd, cfg, p)
-			case filedTypeMap:
-				it.handleMap(cmd, cfg, p)
-			}
-		}
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(node); err != nil {
+		return out
+	}
+	if isJSON {
+		// the client context prints the newline itself
+		return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
 	}
-	s, err := config.RenderYaml(cfg.Root)
+	res, err := yaml.JSONToYAML(buf.Bytes())
 	if err != nil {
-		return string(in)
+		return out
 	}
-	return s
+	return res
 }
 
-func (it coinConverter) resolvePath(cfg *config.Config, path string) (paths []string) {
-	subPaths := strings.SplitN(path, "*", 2)
-	if len(subPaths) == 1 {
-		return []string{path}
+// convertValue walks the JSON node of the value, as marshalled by the proto
+// codec, and converts the nodes of the sdk.Coin and sdk.DecCoin fields
+func (it *coinConverter) convertValue(cmd *cobra.Command, v reflect.Value, node interface{}) (interface{}, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return node, false
+		}
+		if any, ok := v.Interface().(*codectypes.Any); ok {
+			// the fields of the packed value are inlined next to its @type
+			if cached := any.GetCachedValue(); cached != nil {
+				return it.convertValue(cmd, reflect.ValueOf(cached), node)
+			}
+			return node, false
+		}
+		v = v.Elem()
 	}
 
-	if len(subPaths) != 2 {
-		return paths
-	}
+	switch v.Kind() {
+	case reflect.Struct:
+		fields, ok := node.(map[string]interface{})
+		if !ok {
+			return node, false
+		}
+		switch v.Type() {
+		case coinType, decCoinType:
+			return it.convertCoinNode(cmd, fields)
+		}
+		return it.convertFields(cmd, v, fields)
 
-	list, err := cfg.List(subPaths[0][:len(subPaths[0])-1])
-	if err != nil {
-		return paths
+	case reflect.Slice, reflect.Array:
+		list, ok := node.([]interface{})
+		if !ok || v.Type().Elem().Kind() == reflect.Uint8 || len(list) != v.Len() {
+			return node, false
+		}
+		converted := false
+		for i := range list {
+			var c bool
+			list[i], c = it.convertValue(cmd, v.Index(i), list[i])
+			converted = converted || c
+		}
+		return list, converted
+
+	case reflect.Map:
+		entries, ok := node.(map[string]interface{})
+		if !ok {
+			return node, false
+		}
+		converted := false
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			if entry, ok := entries[key]; ok {
+				var c bool
+				entries[key], c = it.convertValue(cmd, iter.Value(), entry)
+				converted = converted || c
+			}
+		}
+		return entries, converted
 	}
+	return node, false
+}
 
-	for i := 0; i < len(list); i++ {
-		p := fmt.Sprintf("%s%d%s", subPaths[0], i, subPaths[1])
-		paths = append(paths, it.resolvePath(cfg, p)...)
+// convertFields converts the JSON fields of a proto message, named after the
+// proto field names
+func (it *coinConverter) convertFields(cmd *cobra.Command, v reflect.Value, fields map[string]interface{}) (interface{}, bool) {
+	converted := false
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		if _, ok := structField.Tag.Lookup("protobuf_oneof"); ok {
+			// the wrapper of the oneof value holds the tagged field
+			var c bool
+			_, c = it.convertValue(cmd, v.Field(i), fields)
+			converted = converted || c
+			continue
+		}
+		name := protoFieldName(structField.Tag.Get("protobuf"))
+		if name == "" {
+			continue
+		}
+		if node, ok := fields[name]; ok {
+			var c bool
+			fields[name], c = it.convertValue(cmd, v.Field(i), node)
+			converted = converted || c
+		}
 	}
-	return paths
+	return fields, converted
 }
 
-func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokentypes.TokenI, err error) {
-	if ft, ok := it.tokens[denom]; ok {
-		return ft, nil
+// convertCoinNode converts a coin node to its main unit
+func (it *coinConverter) convertCoinNode(cmd *cobra.Command, fields map[string]interface{}) (interface{}, bool) {
+	denom, _ := fields["denom"].(string)
+	amount, _ := fields["amount"].(string)
+	srcCoin, err := sdk.ParseDecCoin(amount + denom)
+	if err != nil {
+		return fields, false
 	}
 
-	clientCtx, err := client.GetClientQueryContext(cmd)
+	truncCoin, _ := srcCoin.TruncateDecimal()
+	dstCoin, err := it.convertToMainCoin(cmd, truncCoin)
 	if err != nil {
-		return nil, err
+		return fields, false
 	}
+	return map[string]interface{}{
+		"denom":  dstCoin.Denom,
+		"amount": dstCoin.Amount.String(),
+	}, true
+}
 
-	if err := tokentypes.ValidateSymbol(denom); err != nil {
-		return nil, err
+// protoFieldName returns the field name of a protobuf struct tag
+func protoFieldName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
 	}
+	return ""
+}
 
-	queryClient := tokentypes.NewQueryClient(clientCtx)
+func (it *coinConverter) isQueryCmd(cmd *cobra.Command) bool {
+	return strings.Contains(cmd.CommandPath(), queryCommand().CommandPath())
+}
 
-	res, err := queryClient.Token(context.Background(), &tokentypes.QueryTokenRequest{
-		Denom: denom,
-	})
-	if err != nil {
-		return nil, err
-	}
+// isSignCmd returns true for the commands signing a transaction file
+func (it *coinConverter) isSignCmd(cmd *cobra.Command) bool {
+	return (cmd.Name() == "sign" || cmd.Name() == "multisign") && cmd.Parent() != nil && cmd.Parent().Name() == "tx"
+}
 
-	var evi tokentypes.TokenI
-	err = clientCtx.InterfaceRegistry.UnpackAny(res.Token, &evi)
-	if err != nil {
-		return nil, err
+func (it *coinConverter) isOutputJSON(cmd *cobra.Command) bool {
+	output, err := cmd.Flags().GetString(cli.OutputFlag)
+	return viper.GetString(cli.OutputFlag) == formatJSON || (err == nil && output == formatJSON)
+}
+
+// responseCodec records the query responses, which are decoded by the gRPC
+// codec of the client context
+type responseCodec struct {
+	codec.Codec
+	grpc      encoding.Codec
+	responses []interface{}
+}
+
+// GRPCCodec implements codec.GRPCCodecProvider
+func (c *responseCodec) GRPCCodec() encoding.Codec {
+	return responseDecoder{Codec: c.grpc, codec: c}
+}
+
+// take returns the responses recorded since the last call
+func (c *responseCodec) take() []interface{} {
+	responses := c.responses
+	c.responses = nil
+	return responses
+}
+
+type responseDecoder struct {
+	encoding.Codec
+	codec *responseCodec
+}
+
+func (d responseDecoder) Unmarshal(data []byte, v interface{}) error {
+	d.codec.responses = append(d.codec.responses, v)
+	return d.Codec.Unmarshal(data, v)
+}
+
+// outputConverter is the output of the client context of the query commands,
+// the client context writes each printed response at once
+type outputConverter struct {
+	converter *coinConverter
+	cmd       *cobra.Command
+	out       io.Writer
+}
+
+// Write implements io.Writer
+func (w *outputConverter) Write(p []byte) (int, error) {
+	if _, err := w.out.Write(w.converter.convertOutput(w.cmd, p)); err != nil {
+		return 0, err
 	}
+	return len(p), nil
+}
 
-	it.tokens[denom] = evi
-	return evi, nil
+func (it coinConverter) parseFlags(cmd *cobra.Command, flag *pflag.Flag, cmdNm string) error {
+	if it.hasFromFlag(cmdNm, flag.Name) {
+		srcCoinStr := flag.Value.String()
+		res, err := it.convertCoins(cmd, srcCoinStr)
+		if err != nil {
+			return it.conversionError(flag.Name, err)
+		}
+		_ = flag.Value.Set(res)
+	}
+	return nil
 }
 
-func (it *coinConverter) isOutputYAML(cmd *cobra.Command) bool {
-	output1, err := cmd.Flags().GetString(cli.OutputFlag)
-	output2 := viper.GetString(cli.OutputFlag)
-	if output2 == formatJSON || (err == nil && output1 == formatJSON) {
-		return false
+func (it coinConverter) parseArgs(cmd *cobra.Command, args []string) error {
+	command, ok := it.cmds[cmd.Name()]
+	if !ok {
+		return nil
 	}
-	cmdPath := cmd.CommandPath()
-	if !strings.Contains(cmdPath, queryCommand().CommandPath()) {
-		return false
+
+	if cmd.Parent().Name() != command.parentCmd {
+		return nil
+	}
+
+	if field, ok := it.getFromArgs(cmd.Name()); ok && len(args) > field.index {
+		res, err := it.convertCoins(cmd, args[field.index])
+		if err != nil {
+			return it.conversionError(fmt.Sprintf("argument %d", field.index+1), err)
+		}
+		args[field.index] = res
 	}
-	return true
+	return nil
 }
 
-func (it *coinConverter) handleList(cmd *cobra.Command, cfg *config.Config, path string) {
-	list, err := cfg.List(path)
-	if err != nil {
-		return
+// conversionError returns the error of a failed conversion, the amount being
+// left as is unless the conversion would change its value or the tokens are
+// pinned
+func (it coinConverter) conversionError(name string, err error) error {
+	if errors.Is(err, errNotCoins) {
+		return nil
 	}
-	for i := range list {
-		it.handleMap(cmd, cfg, fmt.Sprintf("%s.%d", path, i))
+	if it.pinned != nil || errors.Is(err, errInexactAmount) {
+		return fmt.Errorf("invalid amount in %s: %w", name, err)
 	}
+	return nil
 }
 
-func (it *coinConverter) handleMap(cmd *cobra.Command, cfg *config.Config, path string) {
-	cMap, err := cfg.Map(path)
-	if err != nil {
-		return
+// queryToken returns the token of the denom, from the token metadata cache
+// or else from the node, unless the command runs offline
+func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokentypes.TokenI, err error) {
+	if ft, ok := it.tokens[denom]; ok {
+		return ft, nil
+	}
+	if it.pinned != nil {
+		metadata, ok := it.pinned.get(denom)
+		if !ok {
+			return nil, fmt.Errorf("token %s not found in the pinned token metadata %s", denom, it.pinned.path)
+		}
+		it.tokens[denom] = metadata.token()
+		return it.tokens[denom], nil
 	}
 
-	bz, err := json.Marshal(cMap)
+	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	var srcCoin sdk.DecCoin
-	if err := json.Unmarshal(bz, &srcCoin); err != nil || !srcCoin.IsValid() {
-		return
+	cache := it.tokenCache(clientCtx)
+	if metadata, ok := cache.get(denom); ok {
+		it.tokens[denom] = metadata.token()
+		return it.tokens[denom], nil
+	}
+	if it.isOffline(cmd) {
+		return nil, fmt.Errorf("token %s not found in %s, run \"%s tokens sync\" to refresh it", denom, cache.path, version.AppName)
 	}
 
-	truncCoin, _ := srcCoin.TruncateDecimal()
-	dstCoin, err := it.convertToMainCoin(cmd, truncCoin)
+	metadata, err := queryTokenMetadata(clientCtx, denom)
 	if err != nil {
-		return
+		return nil, err
+	}
+	// conversions work offline for the tokens seen once
+	cache.put(metadata)
+	_ = cache.save()
+
+	it.tokens[denom] = metadata.token()
+	return it.tokens[denom], nil
+}
+
+// tokenCache returns the token metadata cache of the client home, empty if
+// it was synced from another chain
+func (it *coinConverter) tokenCache(clientCtx client.Context) *tokenCache {
+	if it.cache != nil {
+		return it.cache
+	}
+	cache, _ := loadTokenCache(tokenCachePath(clientCtx.HomeDir))
+	if cache.ChainID != "" && clientCtx.ChainID != "" && cache.ChainID != clientCtx.ChainID {
+		cache = &tokenCache{path: cache.path, ChainID: clientCtx.ChainID}
 	}
-	_ = cfg.Set(path, dstCoin)
+	it.cache = cache
+	return it.cache
 }
 
+func (it *coinConverter) isOffline(cmd *cobra.Command) bool {
+	generateOnly, _ := cmd.Flags().GetBool(flags.FlagGenerateOnly)
+	offline, _ := cmd.Flags().GetBool(flags.FlagOffline)
+	return generateOnly || offline
+}
+
+// convertCoins converts the coins to their min unit, the amounts of the
+// unknown tokens are kept if they are integers
 func (it *coinConverter) convertCoins(cmd *cobra.Command, coinsStr string) (dstCoinsStr string, err error) {
 	cs, err := it.parseCoins(coinsStr)
 	if err != nil {
@@ -393,22 +550,42 @@ func (it *coinConverter) convertCoins(cmd *cobra.Command, coinsStr string) (dstC
 	}
 	dstCoins := sdk.Coins{}
 	for _, coin := range cs {
-		if c, err := it.convertToMinCoin(cmd, coin); err == nil {
-			dstCoins = append(dstCoins, c)
-			continue
+		c, err := it.convertToMinCoin(cmd, coin)
+		if errors.Is(err, errInexactAmount) {
+			return coinsStr, err
+		}
+		if err != nil {
+			if !coin.Amount.IsInteger() {
+				return coinsStr, err
+			}
+			c, _ = coin.TruncateDecimal()
 		}
-		c, _ := coin.TruncateDecimal()
 		dstCoins = append(dstCoins, c)
 	}
 	return dstCoins.String(), nil
 }
 
+// convertToMinCoin converts the coin to its min unit, failing if the amount
+// has more decimals than the scale of the token
 func (it *coinConverter) convertToMinCoin(cmd *cobra.Command, srcCoin sdk.DecCoin) (coin sdk.Coin, err error) {
 	ft, err := it.queryToken(cmd, srcCoin.Denom)
 	if err != nil {
 		return coin, err
 	}
-	return ft.ToMinCoin(srcCoin)
+	coin, err = ft.ToMinCoin(srcCoin)
+	if err != nil {
+		return coin, err
+	}
+
+	exact := srcCoin.Amount.IsInteger()
+	if srcCoin.Denom != ft.GetMinUnit() {
+		mainCoin, err := ft.ToMainCoin(coin)
+		exact = err == nil && mainCoin.Amount.Equal(srcCoin.Amount)
+	}
+	if !exact {
+		return coin, fmt.Errorf("%s has more than %d decimals: %w", formatMainCoin(srcCoin), ft.GetScale(), errInexactAmount)
+	}
+	return coin, nil
 }
 
 func (it *coinConverter) convertToMainCoin(cmd *cobra.Command, srcCoin sdk.Coin) (coin sdk.DecCoin, err error) {
@@ -426,5 +603,5 @@ func (it *coinConverter) parseCoins(srcCoinsStr string) (sdk.DecCoins, error) {
 	if cs, err := sdk.ParseCoinsNormalized(srcCoinsStr); err == nil {
 		return sdk.NewDecCoinsFromCoins(cs...), nil
 	}
-	return sdk.DecCoins{}, fmt.Errorf("parsed decimal coins are invalid: %s", srcCoinsStr)
+	return sdk.DecCoins{}, fmt.Errorf("parsed decimal coins are invalid: %s: %w", srcCoinsStr, errNotCoins)
 }
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/furynet/furyhub/app"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func testConverter(responses ...interface{}) *coinConverter {
+	it := NewConverter()
+	token := tokentypes.NewToken("fury", "Fury", "ufury", 6, 1, 0, true, sdk.AccAddress("owner"))
+	it.tokens["ufury"] = &token
+	it.queried = &responseCodec{responses: responses}
+	return it
+}
+
+func testOutputCmd(output string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String(cli.OutputFlag, output, "")
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+	return cmd
+}
+
+func TestConvertOutput(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	res := &banktypes.QueryAllBalancesResponse{
+		Balances: sdk.NewCoins(sdk.NewInt64Coin("ufury", 1500000), sdk.NewInt64Coin(ibcDenom, 5)),
+	}
+	out, err := cdc.MarshalJSON(res)
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{
+		"balances": [
+			{"denom": "`+ibcDenom+`", "amount": "5"},
+			{"denom": "fury", "amount": "1.500000000000000000"}
+		],
+		"pagination": null
+	}`, string(testConverter(res).convertOutput(testOutputCmd("json"), out)))
+
+	require.Equal(t, `balances:
+- amount: "5"
+  denom: `+ibcDenom+`
+- amount: "1.500000000000000000"
+  denom: fury
+pagination: null
+`, string(testConverter(res).convertOutput(testOutputCmd("text"), []byte(`balances:
+- amount: "5"
+  denom: `+ibcDenom+`
+- amount: "1500000"
+  denom: ufury
+pagination: null
+`))))
+
+	// the output printed after several queries is left as is
+	it := testConverter(res)
+	it.queried.responses = append(it.queried.responses, res)
+	require.Equal(t, string(out), string(it.convertOutput(testOutputCmd("json"), out)))
+}
+
+func TestConvertOutputPackedCoins(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+	account := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), sdk.NewCoins(sdk.NewInt64Coin("ufury", 2000000)), 1, 2,
+	)
+	accountAny, err := codectypes.NewAnyWithValue(account)
+	require.NoError(t, err)
+	res := &authtypes.QueryAccountResponse{Account: accountAny}
+	out, err := cdc.MarshalJSON(res)
+	require.NoError(t, err)
+
+	converted := testConverter(res).convertOutput(testOutputCmd("json"), out)
+	require.Contains(t, string(converted), `"original_vesting":[{"amount":"2.000000000000000000","denom":"fury"}]`)
+	require.Contains(t, string(converted), `"@type":"/cosmos.vesting.v1beta1.ContinuousVestingAccount"`)
+
+	// nothing to convert
+	res = &authtypes.QueryAccountResponse{}
+	out, err = cdc.MarshalJSON(res)
+	require.NoError(t, err)
+	require.Equal(t, string(out), string(testConverter(res).convertOutput(testOutputCmd("json"), out)))
+}
+
+func TestOutputConverterPaginated(t *testing.T) {
+	const (
+		pages    = 3
+		pageSize = 2000
+	)
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	for _, output := range []string{"json", "text"} {
+		it := testConverter()
+		it.queried = &responseCodec{Codec: cdc, grpc: cdc.(codec.GRPCCodecProvider).GRPCCodec()}
+		cmd := testOutputCmd(output)
+		buf := &bytes.Buffer{}
+		clientCtx := client.Context{}.
+			WithCodec(it.queried).
+			WithOutputFormat(output).
+			WithOutput(&outputConverter{converter: it, cmd: cmd, out: buf})
+
+		for page := 0; page < pages; page++ {
+			coins := make(sdk.Coins, 0, pageSize)
+			for i := 0; i < pageSize; i++ {
+				minUnit := fmt.Sprintf("ut%05d", page*pageSize+i)
+				token := tokentypes.NewToken(minUnit[1:], minUnit, minUnit, 6, 1, 0, true, sdk.AccAddress("owner"))
+				it.tokens[minUnit] = &token
+				coins = append(coins, sdk.NewInt64Coin(minUnit, 1500000))
+			}
+			bz, err := cdc.Marshal(&banktypes.QueryTotalSupplyResponse{Supply: coins})
+			require.NoError(t, err)
+
+			// the response is decoded as the gRPC client does
+			var res banktypes.QueryTotalSupplyResponse
+			require.NoError(t, it.queried.GRPCCodec().Unmarshal(bz, &res))
+			written := buf.Len()
+			require.NoError(t, clientCtx.PrintProto(&res))
+
+			// each page is written as soon as it is printed
+			pageOut := buf.Bytes()[written:]
+			require.Greater(t, len(pageOut), 64*1024)
+			if output == "text" {
+				pageOut, err = yaml.YAMLToJSON(pageOut)
+				require.NoError(t, err)
+			}
+			var printed struct {
+				Supply sdk.DecCoins `json:"supply"`
+			}
+			require.NoError(t, json.Unmarshal(pageOut, &printed))
+			require.Len(t, printed.Supply, pageSize)
+			for i, coin := range printed.Supply {
+				require.Equal(t, fmt.Sprintf("t%05d", page*pageSize+i), coin.Denom)
+				require.Equal(t, "1.500000000000000000", coin.Amount.String())
+			}
+		}
+		require.Empty(t, it.queried.responses)
+	}
+}
@@ -1,16 +1,40 @@
 package keystore
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"golang.org/x/crypto/sha3"
 
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
 	sdksecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
+// ExportKeyStore return the keystore file of a secp256k1 private key, encrypted with the password
+func ExportKeyStore(privKey cryptotypes.PrivKey, address, password string) ([]byte, error) {
+	if password == "" {
+		return nil, fmt.Errorf("Password is missing ")
+	}
+	if _, ok := privKey.(*sdksecp256k1.PrivKey); !ok {
+		return nil, fmt.Errorf("unsupported key type %s, only secp256k1 keys can be exported", privKey.Type())
+	}
+
+	encryptedKey, err := encryptKey(privKey.Bytes(), address, password)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(encryptedKey, "", "  ")
+}
+
 // RecoveryAndExportPrivKeyArmor return the new private key armor from a old keystoreFile
 func RecoveryAndExportPrivKeyArmor(keystore []byte, password string) (armor string, err error) {
 	priv, err := recoveryFromKeyStore(keystore, password)
@@ -39,7 +63,46 @@ func recoveryFromKeyStore(keystore []byte, auth string) (tmcrypto.PrivKey, error
 		return nil, fmt.Errorf("Len of Keybytes is not equal to 32 ")
 	}
 
-	return secp256k1.PrivKey(keyBytes), nil
+	privKey := secp256k1.PrivKey(keyBytes)
+	// the legacy files have always been imported whatever their address, only
+	// the address of the version 3 ones is checked
+	if encryptedKey.Version == version3 {
+		if err := checkAddress(encryptedKey.Address, privKey.PubKey().Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	return privKey, nil
+}
+
+// checkAddress checks the address of a keystore file, either a bech32 address
+// of any prefix or a hex address, the Web3 style keystore files holding the
+// Ethereum address of their key
+func checkAddress(address string, pubKey []byte) error {
+	if address == "" {
+		return nil
+	}
+
+	addr := secp256k1.PubKey(pubKey).Address().Bytes()
+	var matches bool
+	if hexAddr, err := hex.DecodeString(strings.TrimPrefix(address, "0x")); err == nil && len(hexAddr) == len(addr) {
+		pub, err := btcec.ParsePubKey(pubKey)
+		if err != nil {
+			return err
+		}
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write(pub.SerializeUncompressed()[1:])
+		matches = bytes.Equal(hexAddr, hash.Sum(nil)[12:]) || bytes.Equal(hexAddr, addr)
+	} else {
+		_, bech32Addr, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return fmt.Errorf("invalid keystore address %s: %w", address, err)
+		}
+		matches = bytes.Equal(bech32Addr, addr)
+	}
+	if !matches {
+		return fmt.Errorf("keystore address %s does not match the address of its key", address)
+	}
+	return nil
 }
 
 func exportPrivKeyArmor(privKey tmcrypto.PrivKey, password string) (armor string, err error) {
@@ -1,9 +1,18 @@
 package keystore
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdksecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestRecoveryAndExportPrivKeyArmor(t *testing.T) {
@@ -11,3 +20,123 @@ func TestRecoveryAndExportPrivKeyArmor(t *testing.T) {
 	_, err := RecoveryAndExportPrivKeyArmor([]byte(keystore), "1234567890")
 	require.NoError(t, err)
 }
+
+func TestExportKeyStore(t *testing.T) {
+	privKey := sdksecp256k1.GenPrivKey()
+	address := sdk.AccAddress(privKey.PubKey().Address()).String()
+
+	bz, err := ExportKeyStore(privKey, address, "1234567890")
+	require.NoError(t, err)
+
+	var encryptedKey EncryptedKeyJSON
+	require.NoError(t, json.Unmarshal(bz, &encryptedKey))
+	require.Equal(t, address, encryptedKey.Address)
+	require.Equal(t, "aes-128-ctr", encryptedKey.Crypto.Cipher)
+	require.Equal(t, "pbkdf2", encryptedKey.Crypto.KDF)
+
+	armor, err := RecoveryAndExportPrivKeyArmor(bz, "1234567890")
+	require.NoError(t, err)
+	recovered, _, err := crypto.UnarmorDecryptPrivKey(armor, "1234567890")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(recovered))
+
+	_, err = RecoveryAndExportPrivKeyArmor(bz, "0987654321")
+	require.ErrorIs(t, err, errDecrypt)
+
+	_, err = ExportKeyStore(ed25519.GenPrivKey(), address, "1234567890")
+	require.Error(t, err)
+}
+
+func TestRecoveryFromKeyStoreFixtures(t *testing.T) {
+	testCases := []struct {
+		file     string
+		password string
+		privKey  string
+	}{
+		{"legacy_pbkdf2_ctr.json", "1234567890", ""},
+		{"v3_pbkdf2_ctr.json", "testpassword", "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"},
+		{"v3_scrypt_ctr.json", "testpassword", "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"},
+		{"scrypt_cbc.json", "testpassword", "f8ad60b6af27eafbe6372cb035618f113898b42a68a095dc95e2b0ea15acd9d1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			bz, err := os.ReadFile(filepath.Join("testdata", tc.file))
+			require.NoError(t, err)
+
+			privKey, err := recoveryFromKeyStore(bz, tc.password)
+			require.NoError(t, err)
+			if tc.privKey != "" {
+				require.Equal(t, tc.privKey, hex.EncodeToString(privKey.Bytes()))
+			}
+
+			_, err = recoveryFromKeyStore(bz, "wrong"+tc.password)
+			require.ErrorIs(t, err, errDecrypt)
+		})
+	}
+}
+
+func TestRecoveryFromMalformedKeyStore(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join("testdata", "legacy_pbkdf2_ctr.json"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		malleate func(key map[string]interface{}, crypto map[string]interface{}, params map[string]interface{})
+		err      string
+	}{
+		{"missing salt", func(_, _, params map[string]interface{}) { delete(params, "salt") }, "salt must be a string"},
+		{"numeric salt", func(_, _, params map[string]interface{}) { params["salt"] = 1 }, "salt must be a string"},
+		{"string dklen", func(_, _, params map[string]interface{}) { params["dklen"] = "32" }, "dklen must be an integer"},
+		{"short dklen", func(_, _, params map[string]interface{}) { params["dklen"] = 16 }, "dklen must be an integer"},
+		{"fractional c", func(_, _, params map[string]interface{}) { params["c"] = 1.5 }, "c must be an integer"},
+		{"missing prf", func(_, _, params map[string]interface{}) { delete(params, "prf") }, "prf must be a string"},
+		{"null kdfparams", func(_, crypto, _ map[string]interface{}) { crypto["kdfparams"] = nil }, "salt must be a string"},
+		{"scrypt without n", func(_, crypto, _ map[string]interface{}) { crypto["kdf"] = "scrypt" }, "n must be an integer"},
+		{"scrypt over the memory cap", func(_, crypto, params map[string]interface{}) {
+			crypto["kdf"] = "scrypt"
+			params["n"], params["r"], params["p"] = 1<<20, 8, 1
+		}, "scrypt would use 1073741824 bytes"},
+		{"unknown kdf", func(_, crypto, _ map[string]interface{}) { crypto["kdf"] = "argon2" }, "Unsupported KDF"},
+		{"unknown cipher", func(_, crypto, _ map[string]interface{}) { crypto["cipher"] = "aes-256-gcm" }, "Unsupported cipher"},
+		{"short iv", func(_, crypto, _ map[string]interface{}) { crypto["cipherparams"] = map[string]interface{}{"iv": "00"} }, "iv must be 16 bytes"},
+		{"unknown version", func(key, _, _ map[string]interface{}) { key["version"] = 2 }, "Unsupported keystore version"},
+		{"other address of a version 3 file", func(key, _, _ map[string]interface{}) {
+			key["version"], key["address"] = 3, "faa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncm9et244"
+		}, "does not match"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var key map[string]interface{}
+			require.NoError(t, json.Unmarshal(bz, &key))
+			crypto := key["crypto"].(map[string]interface{})
+			tc.malleate(key, crypto, crypto["kdfparams"].(map[string]interface{}))
+			malformed, err := json.Marshal(key)
+			require.NoError(t, err)
+
+			require.NotPanics(t, func() {
+				_, err = recoveryFromKeyStore(malformed, "1234567890")
+			})
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// TestRecoveryFromBaselineKeyStore checks that the keystore files accepted
+// before the version and address checks are still imported, a file without a
+// version being a legacy one whose address is not checked
+func TestRecoveryFromBaselineKeyStore(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join("testdata", "legacy_pbkdf2_ctr.json"))
+	require.NoError(t, err)
+	expected, err := recoveryFromKeyStore(bz, "1234567890")
+	require.NoError(t, err)
+
+	bz, err = os.ReadFile(filepath.Join("testdata", "baseline_no_version.json"))
+	require.NoError(t, err)
+	var encryptedKey EncryptedKeyJSON
+	require.NoError(t, json.Unmarshal(bz, &encryptedKey))
+	require.Equal(t, version, encryptedKey.Version)
+
+	privKey, err := recoveryFromKeyStore(bz, "1234567890")
+	require.NoError(t, err)
+	require.Equal(t, expected.Bytes(), privKey.Bytes())
+}
@@ -4,12 +4,44 @@ import (
 	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// version is the version of the keystore files written by encryptKey
+	version = "1"
+	// version3 is the version of the Web3 style keystore files
+	version3 = "3"
+
+	cipherAESCTR = "aes-128-ctr"
+	cipherAESCBC = "aes-128-cbc"
+
+	pbkdf2C     = 262144
+	pbkdf2DKLen = 32
+	pbkdf2PRF   = "hmac-sha256"
+
+	// bounds of the KDF params, keeping malformed keystore files from
+	// exhausting the memory or the time of a decryption
+	maxDKLen   = 64
+	maxPBKDF2C = 1 << 24
+	maxScryptN = 1 << 20
+	maxScryptR = 32
+	maxScryptP = 16
+	// maxScryptMemory bounds the 128·r·N bytes scrypt allocates, which is
+	// 256 MiB for the N=262144, r=8 of the standard keystore files
+	maxScryptMemory = 256 << 20
 )
 
 var (
@@ -46,6 +78,42 @@ type cipherparamsJSON struct {
 	IV string `json:"iv"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, the version being a string in
+// the legacy keystore files and a number in the version 3 ones. The files
+// without a version are legacy ones.
+func (k *EncryptedKeyJSON) UnmarshalJSON(bz []byte) error {
+	type encryptedKeyJSON EncryptedKeyJSON
+	var key struct {
+		encryptedKeyJSON
+		Version json.RawMessage `json:"version"`
+	}
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return err
+	}
+	*k = EncryptedKeyJSON(key.encryptedKeyJSON)
+
+	var v interface{}
+	if len(key.Version) > 0 {
+		if err := json.Unmarshal(key.Version, &v); err != nil {
+			return err
+		}
+	}
+	switch v := v.(type) {
+	case nil:
+		k.Version = version
+	case string:
+		k.Version = v
+	case float64:
+		k.Version = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("invalid keystore version: %s", key.Version)
+	}
+	if k.Version != version && k.Version != version3 {
+		return fmt.Errorf("Unsupported keystore version: %s", k.Version)
+	}
+	return nil
+}
+
 func decryptKey(keyProtected *EncryptedKeyJSON, auth string) ([]byte, error) {
 	mac, err := hex.DecodeString(keyProtected.Crypto.MAC)
 	if err != nil {
@@ -56,62 +124,161 @@ func decryptKey(keyProtected *EncryptedKeyJSON, auth string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("invalid cipher params, the iv must be %d bytes", aes.BlockSize)
+	}
 
 	cipherText, err := hex.DecodeString(keyProtected.Crypto.CipherText)
 	if err != nil {
 		return nil, err
 	}
 
+	var decrypt func(key, cipherText, iv []byte) ([]byte, error)
+	switch keyProtected.Crypto.Cipher {
+	case cipherAESCTR:
+		decrypt = aesCTRXOR
+	case cipherAESCBC:
+		decrypt = aesCBCDecrypt
+	default:
+		return nil, fmt.Errorf("Unsupported cipher: %s", keyProtected.Crypto.Cipher)
+	}
+
 	derivedKey, err := getKDFKey(keyProtected.Crypto, auth)
 	if err != nil {
 		return nil, err
 	}
 
+	// the legacy keystore files are authenticated with SHA-256, the Web3
+	// style ones with Keccak-256
 	bufferValue := make([]byte, len(cipherText)+16)
 	copy(bufferValue[0:16], derivedKey[16:32])
 	copy(bufferValue[16:], cipherText[:])
 	calculatedMAC := sha256.Sum256((bufferValue))
-	if !bytes.Equal(calculatedMAC[:], mac) {
+	keccakMAC := sha3.NewLegacyKeccak256()
+	keccakMAC.Write(bufferValue)
+	if !bytes.Equal(calculatedMAC[:], mac) && !bytes.Equal(keccakMAC.Sum(nil), mac) {
 		return nil, errDecrypt
 	}
 
-	plainText, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
+	plainText, err := decrypt(derivedKey[:16], cipherText, iv)
 	if err != nil {
 		return nil, err
 	}
 	return plainText, err
 }
 
+func encryptKey(keyBytes []byte, address, auth string) (*EncryptedKeyJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	derivedKey := pbkdf2.Key([]byte(auth), salt, pbkdf2C, pbkdf2DKLen, sha256.New)
+	cipherText, err := aesCTRXOR(derivedKey[:16], keyBytes, iv)
+	if err != nil {
+		return nil, err
+	}
+	mac := sha256.Sum256(append(derivedKey[16:32:32], cipherText...))
+
+	return &EncryptedKeyJSON{
+		Address: address,
+		Crypto: CryptoJSON{
+			Cipher:     cipherAESCTR,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: cipherparamsJSON{
+				IV: hex.EncodeToString(iv),
+			},
+			KDF: "pbkdf2",
+			KDFParams: map[string]interface{}{
+				"c":     pbkdf2C,
+				"dklen": pbkdf2DKLen,
+				"prf":   pbkdf2PRF,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac[:]),
+		},
+		ID:      uuid.NewString(),
+		Version: version,
+	}, nil
+}
+
 func getKDFKey(cryptoJSON CryptoJSON, auth string) ([]byte, error) {
 	authArray := []byte(auth)
-	if cryptoJSON.KDFParams["salt"] == nil || cryptoJSON.KDFParams["dklen"] == nil ||
-		cryptoJSON.KDFParams["c"] == nil || cryptoJSON.KDFParams["prf"] == nil {
-		return nil, errors.New("invalid KDF params, must contains c, dklen, prf and salt")
+	params := cryptoJSON.KDFParams
+	saltHex, err := getKDFString(params, "salt")
+	if err != nil {
+		return nil, err
 	}
-	salt, err := hex.DecodeString(cryptoJSON.KDFParams["salt"].(string))
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, err
+	}
+	// the MAC is keyed with the bytes 16 to 32 of the derived key
+	dkLen, err := getKDFInt(params, "dklen", 32, maxDKLen)
 	if err != nil {
 		return nil, err
 	}
-	dkLen := ensureInt(cryptoJSON.KDFParams["dklen"])
 
-	if cryptoJSON.KDF == "pbkdf2" {
-		c := ensureInt(cryptoJSON.KDFParams["c"])
-		prf := cryptoJSON.KDFParams["prf"].(string)
-		if prf != "hmac-sha256" {
+	switch cryptoJSON.KDF {
+	case "pbkdf2":
+		c, err := getKDFInt(params, "c", 1, maxPBKDF2C)
+		if err != nil {
+			return nil, err
+		}
+		prf, err := getKDFString(params, "prf")
+		if err != nil {
+			return nil, err
+		}
+		if prf != pbkdf2PRF {
 			return nil, fmt.Errorf("Unsupported PBKDF2 PRF: %s", prf)
 		}
 		key := pbkdf2.Key(authArray, salt, c, dkLen, sha256.New)
 		return key, nil
+
+	case "scrypt":
+		n, err := getKDFInt(params, "n", 2, maxScryptN)
+		if err != nil {
+			return nil, err
+		}
+		r, err := getKDFInt(params, "r", 1, maxScryptR)
+		if err != nil {
+			return nil, err
+		}
+		p, err := getKDFInt(params, "p", 1, maxScryptP)
+		if err != nil {
+			return nil, err
+		}
+		if 128*r*n > maxScryptMemory {
+			return nil, fmt.Errorf("invalid KDF params, scrypt would use %d bytes with n %d and r %d, more than %d", 128*r*n, n, r, maxScryptMemory)
+		}
+		return scrypt.Key(authArray, salt, n, r, p, dkLen)
 	}
 	return nil, fmt.Errorf("Unsupported KDF: %s", cryptoJSON.KDF)
 }
 
-func ensureInt(x interface{}) int {
-	res, ok := x.(int)
+// getKDFString returns the string KDF param of the key
+func getKDFString(params map[string]interface{}, key string) (string, error) {
+	value, ok := params[key].(string)
 	if !ok {
-		res = int(x.(float64))
+		return "", fmt.Errorf("invalid KDF params, %s must be a string", key)
+	}
+	return value, nil
+}
+
+// getKDFInt returns the integer KDF param of the key, between min and max
+func getKDFInt(params map[string]interface{}, key string, min, max int) (int, error) {
+	value, ok := params[key].(float64)
+	if i, isInt := params[key].(int); isInt {
+		value, ok = float64(i), true
+	}
+	if !ok || value != math.Trunc(value) || value < float64(min) || value > float64(max) {
+		return 0, fmt.Errorf("invalid KDF params, %s must be an integer between %d and %d", key, min, max)
 	}
-	return res
+	return int(value), nil
 }
 
 func aesCTRXOR(key, inText, iv []byte) ([]byte, error) {
@@ -125,3 +292,28 @@ func aesCTRXOR(key, inText, iv []byte) ([]byte, error) {
 	stream.XORKeyStream(outText, inText)
 	return outText, err
 }
+
+func aesCBCDecrypt(key, cipherText, iv []byte) ([]byte, error) {
+	aesBlock, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(cipherText) == 0 || len(cipherText)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid cipher text, its length must be a multiple of the block size")
+	}
+	decrypter := cipher.NewCBCDecrypter(aesBlock, iv)
+	plainText := make([]byte, len(cipherText))
+	decrypter.CryptBlocks(plainText, cipherText)
+
+	// the plain text is padded as per PKCS#7
+	padding := int(plainText[len(plainText)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, errDecrypt
+	}
+	for _, b := range plainText[len(plainText)-padding:] {
+		if int(b) != padding {
+			return nil, errDecrypt
+		}
+	}
+	return plainText[:len(plainText)-padding], nil
+}
@@ -22,6 +22,7 @@ var (
 	// Keys for store prefixes
 	HTLCKey             = []byte{0x01} // prefix for HTLC
 	HTLCExpiredQueueKey = []byte{0x02} // prefix for the HTLC expiration queue
+	MigrationReportKey  = []byte{0xf0} // prefix for the migration reports
 )
 
 // GetHTLCKey returns the key for the HTLC with the specified hash lock
@@ -40,3 +41,9 @@ func GetHTLCExpiredQueueKey(expirationHeight uint64, hashLock []byte) []byte {
 func GetHTLCExpiredQueueSubspace(expirationHeight uint64) []byte {
 	return append(HTLCExpiredQueueKey, sdk.Uint64ToBigEndian(expirationHeight)...)
 }
+
+// GetMigrationReportKey returns the key for the report of the migration run at the given height
+// VALUE: htlc/Report
+func GetMigrationReportKey(height int64) []byte {
+	return append(MigrationReportKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
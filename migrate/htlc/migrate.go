@@ -1,6 +1,8 @@
 package htlc
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -14,32 +16,133 @@ import (
 	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
 )
 
-func Migrate(ctx sdk.Context, cdc codec.Codec, k htlckeeper.Keeper, bk bankkeeper.Keeper, key *storetypes.KVStoreKey) error {
+// Report summarizes a run of the migration
+type Report struct {
+	// Height is the block height the migration ran at
+	Height int64 `json:"height"`
+	// States counts the migrated htlcs by their state before the migration
+	States map[string]uint64 `json:"states"`
+	// AlreadyMigrated counts the htlcs migrated by an earlier run
+	AlreadyMigrated uint64 `json:"already_migrated"`
+	// Refunded is the total refunded to the senders of the expired htlcs
+	Refunded sdk.Coins `json:"refunded"`
+}
+
+// Migrate moves the htlcs to the new format, refunding the expired ones, and
+// logs the report of the run. It writes nothing unless all the htlcs are
+// migrated, and the htlcs migrated by an earlier run are left as they are.
+// The state it writes is the one written by the v1.1 upgrade, so that the
+// chain can be replayed from genesis, which is why the report isn't stored.
+func Migrate(ctx sdk.Context, cdc codec.Codec, k htlckeeper.Keeper, bk bankkeeper.Keeper, key *storetypes.KVStoreKey) (Report, error) {
+	cacheCtx, write := ctx.CacheContext()
+	report, err := migrate(cacheCtx, cdc, k, bk, key, false)
+	if err != nil {
+		return Report{}, err
+	}
+	write()
+
+	logReport(ctx, report)
+	return report, nil
+}
+
+// Rerun runs the migration again after the v1.1 upgrade, migrating the htlcs
+// left in the old format if any, and stores its report under its own key.
+// Unlike Migrate, it leaves the params and the expiration queue as they are.
+func Rerun(ctx sdk.Context, cdc codec.Codec, k htlckeeper.Keeper, bk bankkeeper.Keeper, key *storetypes.KVStoreKey) (Report, error) {
+	cacheCtx, write := ctx.CacheContext()
+	report, err := migrate(cacheCtx, cdc, k, bk, key, true)
+	if err != nil {
+		return Report{}, err
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return Report{}, err
+	}
+	cacheCtx.KVStore(key).Set(GetMigrationReportKey(report.Height), bz)
+	write()
+
+	logReport(ctx, report)
+	return report, nil
+}
+
+// GetReports returns the stored reports of the migration runs, by height
+func GetReports(ctx sdk.Context, key *storetypes.KVStoreKey) ([]Report, error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(key), MigrationReportKey)
+	defer iterator.Close()
+
+	var reports []Report
+	for ; iterator.Valid(); iterator.Next() {
+		var report Report
+		if err := json.Unmarshal(iterator.Value(), &report); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func logReport(ctx sdk.Context, report Report) {
+	ctx.Logger().Info(
+		"htlc migration done",
+		"open", report.States[Open.String()],
+		"completed", report.States[Completed.String()],
+		"expired", report.States[Expired.String()],
+		"refunded", report.States[Refunded.String()],
+		"already_migrated", report.AlreadyMigrated,
+		"refunded_amount", report.Refunded.String(),
+	)
+}
+
+func migrate(ctx sdk.Context, cdc codec.Codec, k htlckeeper.Keeper, bk bankkeeper.Keeper, key *storetypes.KVStoreKey, rerun bool) (Report, error) {
+	report := Report{
+		Height:   ctx.BlockHeight(),
+		States:   make(map[string]uint64),
+		Refunded: sdk.NewCoins(),
+	}
 	if err := k.EnsureModuleAccountPermissions(ctx); err != nil {
-		return err
+		return report, err
 	}
 
 	store := ctx.KVStore(key)
 
-	// Delete expired queue
-	store.Delete(HTLCExpiredQueueKey)
+	if !rerun {
+		// Delete expired queue
+		store.Delete(HTLCExpiredQueueKey)
+	}
 
+	// the new htlcs are stored under the same prefix, so the old ones are
+	// collected ahead of any write
+	type record struct {
+		key   []byte
+		value []byte
+	}
+	var records []record
 	iterator := sdk.KVStorePrefixIterator(store, HTLCKey)
-	defer iterator.Close()
-
 	for ; iterator.Valid(); iterator.Next() {
-		hashLock := tmbytes.HexBytes(iterator.Key()[1:])
+		records = append(records, record{key: iterator.Key(), value: iterator.Value()})
+	}
+	iterator.Close()
+
+	for _, r := range records {
+		if migrated(cdc, r.key, r.value) {
+			report.AlreadyMigrated++
+			continue
+		}
+		hashLock := tmbytes.HexBytes(r.key[len(HTLCKey):])
 
 		var htlc OldHTLC
-		cdc.MustUnmarshal(iterator.Value(), &htlc)
+		if err := cdc.Unmarshal(r.value, &htlc); err != nil {
+			return report, fmt.Errorf("htlc %s: %w", hashLock, err)
+		}
 
 		sender, err := sdk.AccAddressFromBech32(htlc.Sender)
 		if err != nil {
-			return err
+			return report, fmt.Errorf("htlc %s: %w", hashLock, err)
 		}
 		receiver, err := sdk.AccAddressFromBech32(htlc.To)
 		if err != nil {
-			return err
+			return report, fmt.Errorf("htlc %s: %w", hashLock, err)
 		}
 		id := htlctypes.GetID(sender, receiver, htlc.Amount, hashLock)
 		expirationHeight := htlc.ExpirationHeight
@@ -57,12 +160,24 @@ func Migrate(ctx sdk.Context, cdc codec.Codec, k htlckeeper.Keeper, bk bankkeepe
 			// Refund expired htlc
 			state = htlctypes.Refunded
 			if err := bk.SendCoinsFromModuleToAccount(ctx, htlctypes.ModuleName, sender, htlc.Amount); err != nil {
-				return err
+				return report, fmt.Errorf("failed to refund htlc %s: %w", hashLock, err)
 			}
 			closedBlock = uint64(ctx.BlockHeight())
+			report.Refunded = report.Refunded.Add(htlc.Amount...)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					htlctypes.EventTypeRefundHTLC,
+					sdk.NewAttribute(htlctypes.AttributeKeyID, id.String()),
+					sdk.NewAttribute(htlctypes.AttributeKeyHashLock, hashLock.String()),
+					sdk.NewAttribute(htlctypes.AttributeKeySender, htlc.Sender),
+					sdk.NewAttribute(htlctypes.AttributeKeyAmount, htlc.Amount.String()),
+				),
+			)
 		case Refunded:
 			state = htlctypes.Refunded
 		}
+		report.States[htlc.State.String()]++
 
 		// Delete origin htlc
 		store.Delete(GetHTLCKey(hashLock))
@@ -87,10 +202,24 @@ func Migrate(ctx sdk.Context, cdc codec.Codec, k htlckeeper.Keeper, bk bankkeepe
 		k.SetHTLC(ctx, newHTLC, id)
 	}
 
-	// Set default params
-	k.SetParams(ctx, PresetHTLTParams())
+	// a run that migrated the htlcs earlier has set the params, which may
+	// have changed since
+	if !rerun && report.AlreadyMigrated == 0 {
+		// Set default params
+		k.SetParams(ctx, PresetHTLTParams())
+	}
+
+	return report, nil
+}
 
-	return nil
+// migrated tells whether the record is an htlc in the new format, whose id
+// is its key. The first field of an old htlc is its sender instead.
+func migrated(cdc codec.Codec, key, value []byte) bool {
+	var htlc htlctypes.HTLC
+	if err := cdc.Unmarshal(value, &htlc); err != nil {
+		return false
+	}
+	return htlc.Id == tmbytes.HexBytes(key[len(HTLCKey):]).String()
 }
 
 func PresetHTLTParams() htlctypes.Params {
@@ -105,7 +234,7 @@ func PresetHTLTParams() htlctypes.Params {
 					TimePeriod:     time.Duration(0),
 				},
 				Active:        true,
-				DeputyAddress: "did:fury:aa1junhkdhuamtdz3ah6d5mfp6w9sxmlwera7mruz",
+				DeputyAddress: "did:fury:aa1junhkdhuamtdz3ah6d5mfp6w9sxmlwerz83t38",
 				FixedFee:      sdk.NewInt(1000),
 				MinSwapAmount: sdk.NewInt(1001),
 				MaxSwapAmount: sdk.NewInt(1000000000000),
@@ -121,7 +250,7 @@ func PresetHTLTParams() htlctypes.Params {
 					TimePeriod:     time.Duration(0),
 				},
 				Active:        true,
-				DeputyAddress: "did:fury:aa1z2sdef0ypat9lq7wsxrt7ue3uzdnzcsd34wsl4",
+				DeputyAddress: "did:fury:aa1z2sdef0ypat9lq7wsxrt7ue3uzdnzcsdwvycjs",
 				FixedFee:      sdk.NewInt(20000),
 				MinSwapAmount: sdk.NewInt(20001),
 				MaxSwapAmount: sdk.NewInt(15000000000000),
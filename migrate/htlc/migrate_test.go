@@ -0,0 +1,186 @@
+package htlc_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	migratehtlc "github.com/furynet/furyhub/migrate/htlc"
+	"github.com/furynet/furyhub/simapp"
+)
+
+var (
+	sender   = sdk.AccAddress([]byte("sender______________"))
+	receiver = sdk.AccAddress([]byte("receiver____________"))
+)
+
+// setOldHTLC stores an htlc in the format before the migration
+func setOldHTLC(t *testing.T, app *simapp.SimApp, ctx sdk.Context, secret string, amount sdk.Coins, state migratehtlc.HTLCStatus) (tmbytes.HexBytes, tmbytes.HexBytes) {
+	hashLock := sha256.Sum256([]byte(secret))
+	htlc := migratehtlc.OldHTLC{
+		Sender:           sender.String(),
+		To:               receiver.String(),
+		Amount:           amount,
+		Timestamp:        1,
+		ExpirationHeight: 100,
+		State:            state,
+	}
+	store := ctx.KVStore(app.GetKey(htlctypes.StoreKey))
+	store.Set(migratehtlc.GetHTLCKey(hashLock[:]), app.AppCodec().MustMarshal(&htlc))
+	store.Set(migratehtlc.GetHTLCExpiredQueueKey(htlc.ExpirationHeight, hashLock[:]), []byte{})
+	if state == migratehtlc.Open || state == migratehtlc.Expired {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, htlctypes.ModuleName, amount))
+	}
+	return hashLock[:], htlctypes.GetID(sender, receiver, amount, hashLock[:])
+}
+
+func migrate(app *simapp.SimApp, ctx sdk.Context) (migratehtlc.Report, error) {
+	return migratehtlc.Migrate(ctx, app.AppCodec(), app.HTLCKeeper, app.BankKeeper, app.GetKey(htlctypes.StoreKey))
+}
+
+func refundEvents(ctx sdk.Context) int {
+	refunds := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == htlctypes.EventTypeRefundHTLC {
+			refunds++
+		}
+	}
+	return refunds
+}
+
+func TestMigrate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("ufury", amount)) }
+	_, openID := setOldHTLC(t, app, ctx, "open", coins(1), migratehtlc.Open)
+	setOldHTLC(t, app, ctx, "completed", coins(2), migratehtlc.Completed)
+	expiredHashLock, expiredID := setOldHTLC(t, app, ctx, "expired", coins(4), migratehtlc.Expired)
+	setOldHTLC(t, app, ctx, "expired-2", coins(8), migratehtlc.Expired)
+	setOldHTLC(t, app, ctx, "refunded", coins(16), migratehtlc.Refunded)
+
+	report, err := migrate(app, ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), report.Height)
+	require.Equal(t, map[string]uint64{
+		"HTLC_STATE_OPEN":      1,
+		"HTLC_STATE_COMPLETED": 1,
+		"HTLC_STATE_EXPIRED":   2,
+		"HTLC_STATE_REFUNDED":  1,
+	}, report.States)
+	require.Zero(t, report.AlreadyMigrated)
+	require.Equal(t, coins(12), report.Refunded)
+	require.Equal(t, coins(12), app.BankKeeper.GetAllBalances(ctx, sender))
+
+	htlc, found := app.HTLCKeeper.GetHTLC(ctx, expiredID)
+	require.True(t, found)
+	require.Equal(t, htlctypes.Refunded, htlc.State)
+	require.Equal(t, expiredHashLock.String(), htlc.HashLock)
+	require.Equal(t, uint64(10), htlc.ClosedBlock)
+	htlc, found = app.HTLCKeeper.GetHTLC(ctx, openID)
+	require.True(t, found)
+	require.Equal(t, htlctypes.Open, htlc.State)
+
+	// the open htlc is queued under its new id, the queue entries of the old
+	// format being left as the v1.1 upgrade left them
+	var queued []tmbytes.HexBytes
+	app.HTLCKeeper.IterateHTLCExpiredQueueByHeight(ctx, 100, func(id tmbytes.HexBytes, _ htlctypes.HTLC) bool {
+		queued = append(queued, id)
+		return false
+	})
+	require.Contains(t, queued, openID)
+	store := ctx.KVStore(app.GetKey(htlctypes.StoreKey))
+	require.True(t, store.Has(migratehtlc.GetHTLCExpiredQueueKey(100, expiredHashLock)))
+
+	require.Equal(t, 2, refundEvents(ctx))
+
+	// running again changes nothing, not even the params set since
+	params := app.HTLCKeeper.GetParams(ctx)
+	params.AssetParams = params.AssetParams[:1]
+	app.HTLCKeeper.SetParams(ctx, params)
+
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 11})
+	report, err = migrate(app, ctx)
+	require.NoError(t, err)
+	require.Empty(t, report.States)
+	require.Equal(t, uint64(5), report.AlreadyMigrated)
+	require.True(t, report.Refunded.IsZero())
+	require.Equal(t, coins(12), app.BankKeeper.GetAllBalances(ctx, sender))
+	require.Equal(t, params, app.HTLCKeeper.GetParams(ctx))
+	require.Zero(t, refundEvents(ctx))
+}
+
+func TestRerun(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	key := app.GetKey(htlctypes.StoreKey)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ufury", 4))
+	setOldHTLC(t, app, ctx, "migrated", amount, migratehtlc.Open)
+	_, err := migrate(app, ctx)
+	require.NoError(t, err)
+	params := app.HTLCKeeper.GetParams(ctx)
+	params.AssetParams = params.AssetParams[:1]
+	app.HTLCKeeper.SetParams(ctx, params)
+
+	// an htlc left in the old format is migrated, the params and the queue
+	// entries of the old format being left as they are
+	expiredHashLock, expiredID := setOldHTLC(t, app, ctx, "left", amount, migratehtlc.Expired)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 20})
+	report, err := migratehtlc.Rerun(ctx, app.AppCodec(), app.HTLCKeeper, app.BankKeeper, key)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"HTLC_STATE_EXPIRED": 1}, report.States)
+	require.Equal(t, uint64(1), report.AlreadyMigrated)
+	require.Equal(t, amount, report.Refunded)
+	require.Equal(t, params, app.HTLCKeeper.GetParams(ctx))
+	htlc, found := app.HTLCKeeper.GetHTLC(ctx, expiredID)
+	require.True(t, found)
+	require.Equal(t, htlctypes.Refunded, htlc.State)
+	require.True(t, ctx.KVStore(key).Has(migratehtlc.GetHTLCExpiredQueueKey(100, expiredHashLock)))
+
+	// the reports of the reruns are stored, the one of the v1.1 run is not
+	reports, err := migratehtlc.GetReports(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, []migratehtlc.Report{report}, reports)
+}
+
+func TestMigratePartialFailure(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ufury", 4))
+	hashLocks := make([]tmbytes.HexBytes, 0, 3)
+	for _, secret := range []string{"a", "b", "c"} {
+		hashLock, _ := setOldHTLC(t, app, ctx, secret, amount, migratehtlc.Expired)
+		hashLocks = append(hashLocks, hashLock)
+	}
+	// the escrow cannot refund all the expired htlcs
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, htlctypes.ModuleName, amount))
+
+	_, err := migrate(app, ctx)
+	require.ErrorContains(t, err, "failed to refund htlc")
+
+	// nothing is written
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, sender).IsZero())
+	store := ctx.KVStore(app.GetKey(htlctypes.StoreKey))
+	for _, hashLock := range hashLocks {
+		require.True(t, store.Has(migratehtlc.GetHTLCKey(hashLock)))
+		require.True(t, store.Has(migratehtlc.GetHTLCExpiredQueueKey(100, hashLock)))
+	}
+	require.Zero(t, refundEvents(ctx))
+
+	// the migration completes once the escrow is funded
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, htlctypes.ModuleName, amount))
+	report, err := migrate(app, ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), report.States["HTLC_STATE_EXPIRED"])
+	require.Equal(t, amount.MulInt(sdk.NewInt(3)), app.BankKeeper.GetAllBalances(ctx, sender))
+}
@@ -1,8 +1,10 @@
 package tibc
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +13,10 @@ import (
 	"github.com/bianjieai/tibc-go/modules/tibc/core/exported"
 )
 
+// PlanInfoKey is the key of the TIBC client definitions in the JSON info of
+// an upgrade plan
+const PlanInfoKey = "tibc_clients"
+
 //go:embed v120.json
 var v120 []byte
 
@@ -18,11 +24,13 @@ var v120 []byte
 var v130 []byte
 
 type (
+	// ClientData is the JSON definition of a TIBC client, its states are
+	// encoded as Any
 	ClientData struct {
-		ChainName      string                 `json:"chain_name"`
-		ClientState    map[string]interface{} `json:"client_state"`
-		ConsensusState map[string]interface{} `json:"consensus_state"`
-		Relayers       []string               `json:"relayers"`
+		ChainName      string          `json:"chain_name"`
+		ClientState    json.RawMessage `json:"client_state"`
+		ConsensusState json.RawMessage `json:"consensus_state"`
+		Relayers       []string        `json:"relayers"`
 	}
 
 	Client struct {
@@ -33,13 +41,33 @@ type (
 	}
 )
 
+// CreateClient creates the TIBC clients bundled with the given past upgrade,
+// overwriting the existing ones as the shipped upgrade did
 func CreateClient(
 	ctx sdk.Context,
 	cdc codec.Codec,
 	upgradePlanVersion string,
 	clientKeeper clientkeeper.Keeper,
 ) error {
-	clients := loadClient(cdc, upgradePlanVersion)
+	clients, err := EmbeddedClients(cdc, upgradePlanVersion)
+	if err != nil {
+		return err
+	}
+	return createClients(ctx, clientKeeper, clients)
+}
+
+// CreateClients creates the given TIBC clients and registers their relayers,
+// nothing being created if any of them exists
+func CreateClients(ctx sdk.Context, clientKeeper clientkeeper.Keeper, clients []Client) error {
+	for _, client := range clients {
+		if _, found := clientKeeper.GetClientState(ctx, client.ChainName); found {
+			return fmt.Errorf("tibc client %s already exists", client.ChainName)
+		}
+	}
+	return createClients(ctx, clientKeeper, clients)
+}
+
+func createClients(ctx sdk.Context, clientKeeper clientkeeper.Keeper, clients []Client) error {
 	for _, client := range clients {
 		// init tibc client
 		if err := clientKeeper.CreateClient(
@@ -56,45 +84,112 @@ func CreateClient(
 	return nil
 }
 
-func loadClient(cdc codec.Codec, version string) (clients []Client) {
+// EmbeddedClients returns the TIBC clients bundled with the given past upgrade
+func EmbeddedClients(cdc codec.Codec, version string) ([]Client, error) {
 	var data []byte
 	switch version {
 	case "v1.2":
 		data = v120
 	case "v1.3":
 		data = v130
+	default:
+		return nil, fmt.Errorf("no tibc clients bundled with upgrade %s", version)
 	}
+	// the bundled clients were created on chain as they are, some of them
+	// would not pass the current validation
+	return decodeClients(cdc, data, false)
+}
+
+// PlanInfoClients returns the TIBC clients defined under PlanInfoKey in the
+// info of an upgrade plan. An info which is not a JSON object, such as a
+// plain text or an URL, defines no client.
+func PlanInfoClients(cdc codec.Codec, info string) ([]Client, error) {
+	bz := bytes.TrimSpace([]byte(info))
+	if len(bz) == 0 || bz[0] != '{' {
+		return nil, nil
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &payload); err != nil {
+		return nil, fmt.Errorf("invalid upgrade plan info: %w", err)
+	}
+	data, ok := payload[PlanInfoKey]
+	if !ok {
+		return nil, nil
+	}
+	return ParseClients(cdc, data)
+}
+
+// ParseClients decodes and validates a JSON list of TIBC client definitions
+func ParseClients(cdc codec.Codec, bz []byte) ([]Client, error) {
+	return decodeClients(cdc, bz, true)
+}
 
+func decodeClients(cdc codec.Codec, bz []byte, validate bool) ([]Client, error) {
 	var datas []ClientData
-	if err := json.Unmarshal(data, &datas); err != nil {
-		panic("Unmarshal client.json failed")
+	if err := json.Unmarshal(bz, &datas); err != nil {
+		return nil, fmt.Errorf("invalid tibc client definitions: %w", err)
 	}
 
-	for _, data := range datas {
-		clientStateBz, err := json.Marshal(data.ClientState)
+	clients := make([]Client, len(datas))
+	seen := make(map[string]bool, len(datas))
+	for i, data := range datas {
+		client, err := data.decode(cdc)
 		if err != nil {
-			panic("Marshal ClientState failed")
+			return nil, err
 		}
-		var clientState exported.ClientState
-		if err := cdc.UnmarshalInterfaceJSON(clientStateBz, &clientState); err != nil {
-			panic("UnmarshalInterfaceJSON ClientState failed")
+		if validate {
+			if err := client.Validate(); err != nil {
+				return nil, err
+			}
 		}
-
-		consensusStateBz, err := json.Marshal(data.ConsensusState)
-		if err != nil {
-			panic("Marshal ConsensusState failed")
-		}
-		var consensusState exported.ConsensusState
-		if err := cdc.UnmarshalInterfaceJSON(consensusStateBz, &consensusState); err != nil {
-			panic("UnmarshalInterfaceJSON ConsensusState failed")
+		if seen[client.ChainName] {
+			return nil, fmt.Errorf("tibc client %s defined twice", client.ChainName)
 		}
+		seen[client.ChainName] = true
+		clients[i] = client
+	}
+	return clients, nil
+}
 
-		clients = append(clients, Client{
-			ChainName:      data.ChainName,
-			ClientState:    clientState,
-			ConsensusState: consensusState,
-			Relayers:       data.Relayers,
-		})
+// decode decodes the states of the client definition
+func (data ClientData) decode(cdc codec.Codec) (Client, error) {
+	if len(data.ChainName) == 0 {
+		return Client{}, fmt.Errorf("tibc client chain name missing")
 	}
-	return
+
+	var clientState exported.ClientState
+	if err := cdc.UnmarshalInterfaceJSON(data.ClientState, &clientState); err != nil {
+		return Client{}, fmt.Errorf("invalid client state of tibc client %s: %w", data.ChainName, err)
+	}
+	var consensusState exported.ConsensusState
+	if err := cdc.UnmarshalInterfaceJSON(data.ConsensusState, &consensusState); err != nil {
+		return Client{}, fmt.Errorf("invalid consensus state of tibc client %s: %w", data.ChainName, err)
+	}
+
+	return Client{
+		ChainName:      data.ChainName,
+		ClientState:    clientState,
+		ConsensusState: consensusState,
+		Relayers:       data.Relayers,
+	}, nil
+}
+
+// Validate checks the states of the client and its relayers
+func (client Client) Validate() error {
+	if err := client.ClientState.Validate(); err != nil {
+		return fmt.Errorf("invalid client state of tibc client %s: %w", client.ChainName, err)
+	}
+	if err := client.ConsensusState.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid consensus state of tibc client %s: %w", client.ChainName, err)
+	}
+	if len(client.Relayers) == 0 {
+		return fmt.Errorf("tibc client %s has no relayer", client.ChainName)
+	}
+	for _, relayer := range client.Relayers {
+		if _, err := sdk.AccAddressFromBech32(relayer); err != nil {
+			return fmt.Errorf("invalid relayer %s of tibc client %s: %w", relayer, client.ChainName, err)
+		}
+	}
+	return nil
 }
@@ -1,10 +1,16 @@
 package tibc_test
 
 import (
+	"encoding/json"
+	"os"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	migratetibc "github.com/furynet/furyhub/migrate/tibc"
 	"github.com/furynet/furyhub/simapp"
 )
@@ -12,5 +18,81 @@ import (
 func TestLoadClient(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
-	migratetibc.CreateClient(ctx, app.AppCodec(), "v1.3", app.TIBCKeeper.ClientKeeper)
+	require.NoError(t, migratetibc.CreateClient(ctx, app.AppCodec(), "v1.3", app.TIBCKeeper.ClientKeeper))
+
+	_, found := app.TIBCKeeper.ClientKeeper.GetClientState(ctx, "bsc-mainnet")
+	require.True(t, found)
+
+	// the bundled clients are overwritten as the shipped upgrades did
+	require.NoError(t, migratetibc.CreateClient(ctx, app.AppCodec(), "v1.3", app.TIBCKeeper.ClientKeeper))
+	require.Error(t, migratetibc.CreateClient(ctx, app.AppCodec(), "v9.9", app.TIBCKeeper.ClientKeeper))
+}
+
+func TestPlanInfoClients(t *testing.T) {
+	app := simapp.Setup(t, false)
+	cdc := app.AppCodec()
+
+	bz, err := os.ReadFile("v130.json")
+	require.NoError(t, err)
+	var datas []migratetibc.ClientData
+	require.NoError(t, json.Unmarshal(bz, &datas))
+	relayer := sdk.AccAddress([]byte("relayer_____________")).String()
+	datas[0].Relayers = []string{relayer}
+
+	info := func(datas []migratetibc.ClientData) string {
+		bz, err := json.Marshal(map[string]interface{}{
+			"binaries":              map[string]string{"linux/amd64": "https://example.com/grid"},
+			migratetibc.PlanInfoKey: datas,
+		})
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	clients, err := migratetibc.PlanInfoClients(cdc, info(datas))
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+	require.Equal(t, "bsc-mainnet", clients[0].ChainName)
+	require.Equal(t, []string{relayer}, clients[0].Relayers)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	require.NoError(t, migratetibc.CreateClients(ctx, app.TIBCKeeper.ClientKeeper, clients))
+	require.Equal(t, []string{relayer}, app.TIBCKeeper.ClientKeeper.GetRelayers(ctx, "bsc-mainnet"))
+	require.ErrorContains(t, migratetibc.CreateClients(ctx, app.TIBCKeeper.ClientKeeper, clients), "already exists")
+
+	// no client
+	for _, info := range []string{"", "https://example.com/info.json", `{"binaries":{}}`} {
+		clients, err := migratetibc.PlanInfoClients(cdc, info)
+		require.NoError(t, err)
+		require.Empty(t, clients)
+	}
+
+	invalid := func(malleate func(data *migratetibc.ClientData)) string {
+		data := datas[0]
+		data.Relayers = append([]string{}, data.Relayers...)
+		malleate(&data)
+		return info([]migratetibc.ClientData{data})
+	}
+	for name, info := range map[string]string{
+		"malformed info":    `{"tibc_clients":`,
+		"malformed clients": `{"tibc_clients":{}}`,
+		"duplicate":         info([]migratetibc.ClientData{datas[0], datas[0]}),
+		"no chain name": invalid(func(data *migratetibc.ClientData) {
+			data.ChainName = ""
+		}),
+		"unknown client state": invalid(func(data *migratetibc.ClientData) {
+			data.ClientState = json.RawMessage(`{"@type":"/unknown.ClientState"}`)
+		}),
+		"consensus state as client state": invalid(func(data *migratetibc.ClientData) {
+			data.ClientState = data.ConsensusState
+		}),
+		"no relayer": invalid(func(data *migratetibc.ClientData) {
+			data.Relayers = nil
+		}),
+		"invalid relayer": invalid(func(data *migratetibc.ClientData) {
+			data.Relayers = []string{"relayer"}
+		}),
+	} {
+		_, err := migratetibc.PlanInfoClients(cdc, info)
+		require.Error(t, err, name)
+	}
 }
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/furynet/furyhub/address"
+	"github.com/furynet/furyhub/modules/did/types"
+)
+
+// GetQueryCmd returns the cli query commands for the did module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the did module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryResolve(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryResolve implements a command to resolve a DID into its DID document.
+func GetCmdQueryResolve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [did]",
+		Short: "Resolve a DID into its DID document",
+		Long: `Resolve a DID, an account address, into its DID document, built from the account public key,
+the consensus key of the validator it operates and the URIs of the records it created. The
+document is printed in the JSON-LD representation of the W3C DID Core, whatever the output.`,
+		Example: fmt.Sprintf("%s query did resolve %s1...", version.AppName, address.Bech32PrefixAccAddr),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Resolve(context.Background(), &types.QueryResolveRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			bz, err := res.Document.MarshalJSONLD()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
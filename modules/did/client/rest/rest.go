@@ -0,0 +1,56 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/furynet/furyhub/modules/did/types"
+)
+
+// ContentTypeDIDLDJSON is the media type of the JSON-LD representation of the
+// DID documents
+const ContentTypeDIDLDJSON = "application/did+ld+json"
+
+// RegisterHandlers registers did module REST handlers on the provided router.
+func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/did/{id}", resolveHandlerFn(clientCtx)).Methods("GET")
+}
+
+// HTTP request handler to resolve a DID into its DID document
+func resolveHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient := types.NewQueryClient(clientCtx)
+		res, err := queryClient.Resolve(r.Context(), &types.QueryResolveRequest{Id: mux.Vars(r)["id"]})
+		if err != nil {
+			code := http.StatusInternalServerError
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				code = http.StatusBadRequest
+			case codes.NotFound:
+				code = http.StatusNotFound
+			}
+			writeError(w, code, status.Convert(err).Message())
+			return
+		}
+
+		bz, err := res.Document.MarshalJSONLD()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", ContentTypeDIDLDJSON)
+		_, _ = w.Write(bz)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/did/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Resolve resolves a DID into its DID document
+func (k Keeper) Resolve(c context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := types.ParseDID(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	doc, found := k.resolve(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "DID %s not found", req.Id)
+	}
+	return &types.QueryResolveResponse{Document: doc}, nil
+}
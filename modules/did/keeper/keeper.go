@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+
+	"github.com/furynet/furyhub/modules/did/types"
+)
+
+// Keeper builds the DID documents of the accounts from the chain state
+type Keeper struct {
+	cdc           codec.Codec
+	storeKey      storetypes.StoreKey
+	accountKeeper types.AccountKeeper
+	stakingKeeper types.StakingKeeper
+	recordKeeper  types.RecordKeeper
+}
+
+// NewKeeper returns a did keeper
+func NewKeeper(
+	cdc codec.Codec,
+	key storetypes.StoreKey,
+	accountKeeper types.AccountKeeper,
+	stakingKeeper types.StakingKeeper,
+	recordKeeper types.RecordKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		accountKeeper: accountKeeper,
+		stakingKeeper: stakingKeeper,
+		recordKeeper:  recordKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("furyhub/%s", types.ModuleName))
+}
+
+// resolve builds the DID document of the account: its public key, the
+// consensus key of the validator it operates and the URIs of the records it
+// created, returning false if the account does not exist
+func (k Keeper) resolve(ctx sdk.Context, addr sdk.AccAddress) (types.Document, bool) {
+	account := k.accountKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return types.Document{}, false
+	}
+
+	did := addr.String()
+	doc := types.Document{Id: did}
+
+	// the account has no public key until it signs a transaction
+	if pubKey := account.GetPubKey(); pubKey != nil {
+		pubKeys := []cryptotypes.PubKey{pubKey}
+		if multisigKey, ok := pubKey.(*multisig.LegacyAminoPubKey); ok {
+			pubKeys = multisigKey.GetPubKeys()
+		}
+		for i, pubKey := range pubKeys {
+			fragment := "account-key"
+			if len(pubKeys) > 1 {
+				fragment = fmt.Sprintf("account-key-%d", i+1)
+			}
+			method, err := types.NewVerificationMethod(did, fragment, pubKey)
+			if err != nil {
+				k.Logger(ctx).Debug("account key left out of the DID document", "did", did, "err", err)
+				continue
+			}
+			doc.VerificationMethods = append(doc.VerificationMethods, method)
+			doc.Authentication = append(doc.Authentication, method.Id)
+			doc.AssertionMethod = append(doc.AssertionMethod, method.Id)
+		}
+	}
+
+	if validator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(addr)); found {
+		if consPubKey, err := validator.ConsPubKey(); err == nil {
+			if method, err := types.NewVerificationMethod(did, "validator-consensus-key", consPubKey); err == nil {
+				doc.VerificationMethods = append(doc.VerificationMethods, method)
+			}
+		}
+	}
+
+	doc.Services = k.recordServices(ctx, addr)
+	return doc, true
+}
+
+// SetRecord adds the record to the index of the records by creator
+func (k Keeper) SetRecord(ctx sdk.Context, creator sdk.AccAddress, recordID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordKey(creator, recordID), []byte{})
+}
+
+// IndexRecords adds all the records of the record module to the index of the
+// records by creator
+func (k Keeper) IndexRecords(ctx sdk.Context) {
+	iterator := k.recordKeeper.RecordsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record recordtypes.Record
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			continue
+		}
+		creator, err := sdk.AccAddressFromBech32(record.Creator)
+		if err != nil {
+			continue
+		}
+		k.SetRecord(ctx, creator, iterator.Key()[len(recordtypes.RecordKey):])
+	}
+}
+
+// recordServices returns the URIs of the records created by the account as
+// services, looked up in the index of the records by creator
+func (k Keeper) recordServices(ctx sdk.Context, addr sdk.AccAddress) (services []types.Service) {
+	did := addr.String()
+	prefix := types.GetRecordsSubspaceKey(addr)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		recordID := iterator.Key()[len(prefix):]
+		record, found := k.recordKeeper.GetRecord(ctx, recordID)
+		if !found {
+			continue
+		}
+		for i, content := range record.Contents {
+			if content.URI == "" {
+				continue
+			}
+			services = append(services, types.Service{
+				Id:              fmt.Sprintf("%s#record-%X-%d", did, recordID, i+1),
+				Type:            types.ServiceLinkedRecord,
+				ServiceEndpoint: content.URI,
+			})
+		}
+	}
+	return services
+}
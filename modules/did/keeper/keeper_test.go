@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/btcutil/base58"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	recordkeeper "github.com/irisnet/irismod/modules/record/keeper"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+
+	"github.com/furynet/furyhub/modules/did/keeper"
+	"github.com/furynet/furyhub/modules/did/types"
+	"github.com/furynet/furyhub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *simapp.SimApp
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.DIDKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) setAccount(pubKey cryptotypes.PubKey) sdk.AccAddress {
+	addr := sdk.AccAddress(pubKey.Address())
+	account := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(account.SetPubKey(pubKey))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+	return addr
+}
+
+// createRecord creates a record through the record MsgServer wrapped by the did keeper
+func (suite *KeeperTestSuite) createRecord(creator string, contents []recordtypes.Content) string {
+	msgServer := keeper.NewRecordMsgServerImpl(recordkeeper.NewMsgServerImpl(suite.app.RecordKeeper), suite.app.DIDKeeper)
+	res, err := msgServer.CreateRecord(sdk.WrapSDKContext(suite.ctx), recordtypes.NewMsgCreateRecord(contents, creator))
+	suite.Require().NoError(err)
+	return res.Id
+}
+
+func (suite *KeeperTestSuite) resolve(addr sdk.AccAddress) types.Document {
+	res, err := suite.queryClient.Resolve(gocontext.Background(), &types.QueryResolveRequest{Id: addr.String()})
+	suite.Require().NoError(err)
+	return res.Document
+}
+
+func (suite *KeeperTestSuite) TestResolveAccount() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := suite.setAccount(pubKey)
+	did := addr.String()
+
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(addr), consPubKey, stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+
+	recordID := suite.createRecord(did, []recordtypes.Content{
+		{Digest: "digest", DigestAlgo: "sha256", URI: "https://example.com/profile"},
+		{Digest: "digest", DigestAlgo: "sha256"},
+	})
+	suite.createRecord(sdk.AccAddress("other").String(), []recordtypes.Content{
+		{Digest: "digest", DigestAlgo: "sha256", URI: "https://example.com/other"},
+	})
+
+	doc := suite.resolve(addr)
+	suite.Equal(did, doc.Id)
+	suite.Require().Len(doc.VerificationMethods, 2)
+	suite.Equal(did+"#account-key", doc.VerificationMethods[0].Id)
+	suite.Equal(types.VerificationMethodMultikey, doc.VerificationMethods[0].Type)
+	suite.Equal("z"+base58.Encode(append([]byte{0xe7, 0x01}, pubKey.Bytes()...)), doc.VerificationMethods[0].PublicKeyMultibase)
+	suite.Equal(did, doc.VerificationMethods[0].Controller)
+	suite.Equal(did+"#validator-consensus-key", doc.VerificationMethods[1].Id)
+	suite.Equal(types.VerificationMethodMultikey, doc.VerificationMethods[1].Type)
+	suite.Equal("z"+base58.Encode(append([]byte{0xed, 0x01}, consPubKey.Bytes()...)), doc.VerificationMethods[1].PublicKeyMultibase)
+	suite.Equal([]string{did + "#account-key"}, doc.Authentication)
+	suite.Equal([]string{did + "#account-key"}, doc.AssertionMethod)
+	suite.Equal([]types.Service{{
+		Id:              fmt.Sprintf("%s#record-%s-1", did, strings.ToUpper(recordID)),
+		Type:            types.ServiceLinkedRecord,
+		ServiceEndpoint: "https://example.com/profile",
+	}}, doc.Services)
+
+	bz, err := doc.MarshalJSONLD()
+	suite.Require().NoError(err)
+	var jsonLD map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(bz, &jsonLD))
+	suite.Equal([]interface{}{types.ContextDID, types.ContextMultikey}, jsonLD["@context"])
+	suite.Len(jsonLD["verificationMethod"], 2)
+	suite.Contains(jsonLD["verificationMethod"].([]interface{})[0], "publicKeyMultibase")
+	suite.Len(jsonLD["service"], 1)
+}
+
+func (suite *KeeperTestSuite) TestIndexRecords() {
+	addr := suite.setAccount(secp256k1.GenPrivKey().PubKey())
+	did := addr.String()
+
+	// the records added before the did module are indexed by its genesis
+	recordID := suite.app.RecordKeeper.AddRecord(suite.ctx, recordtypes.Record{
+		Contents: []recordtypes.Content{{Digest: "digest", DigestAlgo: "sha256", URI: "https://example.com/profile"}},
+		Creator:  did,
+	})
+	suite.Empty(suite.resolve(addr).Services)
+
+	suite.app.DIDKeeper.IndexRecords(suite.ctx)
+	suite.Equal([]types.Service{{
+		Id:              fmt.Sprintf("%s#record-%X-1", did, recordID),
+		Type:            types.ServiceLinkedRecord,
+		ServiceEndpoint: "https://example.com/profile",
+	}}, suite.resolve(addr).Services)
+}
+
+func (suite *KeeperTestSuite) TestResolveMultisigAccount() {
+	pubKeys := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	addr := suite.setAccount(multisig.NewLegacyAminoPubKey(1, pubKeys))
+	did := addr.String()
+
+	doc := suite.resolve(addr)
+	suite.Require().Len(doc.VerificationMethods, 2)
+	suite.Equal([]string{did + "#account-key-1", did + "#account-key-2"}, doc.Authentication)
+	suite.Empty(doc.Services)
+}
+
+func (suite *KeeperTestSuite) TestResolveInvalid() {
+	// the account without public key has an empty document
+	addr := sdk.AccAddress("no_pubkey")
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	suite.Equal(types.Document{Id: addr.String()}, suite.resolve(addr))
+
+	_, err := suite.queryClient.Resolve(gocontext.Background(), &types.QueryResolveRequest{Id: sdk.AccAddress("unknown").String()})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	for _, id := range []string{"", "did:fury:aa1invalid", sdk.ValAddress(addr).String(), "did:web:example.com"} {
+		_, err = suite.queryClient.Resolve(gocontext.Background(), &types.QueryResolveRequest{Id: id})
+		suite.Equal(codes.InvalidArgument, status.Code(err), id)
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+)
+
+type recordMsgServer struct {
+	recordtypes.MsgServer
+	keeper Keeper
+}
+
+var _ recordtypes.MsgServer = recordMsgServer{}
+
+// NewRecordMsgServerImpl wraps the MsgServer of the record module so that
+// the records it creates are added to the index of the records by creator
+func NewRecordMsgServerImpl(server recordtypes.MsgServer, keeper Keeper) recordtypes.MsgServer {
+	return recordMsgServer{MsgServer: server, keeper: keeper}
+}
+
+// CreateRecord creates the record and indexes it by creator
+func (m recordMsgServer) CreateRecord(goCtx context.Context, msg *recordtypes.MsgCreateRecord) (*recordtypes.MsgCreateRecordResponse, error) {
+	res, err := m.MsgServer.CreateRecord(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	recordID, err := hex.DecodeString(res.Id)
+	if err != nil {
+		return nil, err
+	}
+	m.keeper.SetRecord(sdk.UnwrapSDKContext(goCtx), creator, recordID)
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package did
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/irisnet/irismod/modules/record"
+	recordkeeper "github.com/irisnet/irismod/modules/record/keeper"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+
+	"github.com/furynet/furyhub/modules/did/keeper"
+)
+
+// RecordAppModule wraps the AppModule of the record module so that the
+// records created by its messages are indexed by creator
+type RecordAppModule struct {
+	record.AppModule
+
+	recordKeeper recordkeeper.Keeper
+	keeper       keeper.Keeper
+}
+
+// NewRecordAppModule creates a new RecordAppModule object
+func NewRecordAppModule(recordModule record.AppModule, recordKeeper recordkeeper.Keeper, keeper keeper.Keeper) RecordAppModule {
+	return RecordAppModule{
+		AppModule:    recordModule,
+		recordKeeper: recordKeeper,
+		keeper:       keeper,
+	}
+}
+
+// RegisterServices registers the services of the record module, its MsgServer
+// indexing the records it creates.
+func (am RecordAppModule) RegisterServices(cfg module.Configurator) {
+	recordtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewRecordMsgServerImpl(recordkeeper.NewMsgServerImpl(am.recordKeeper), am.keeper))
+	recordtypes.RegisterQueryServer(cfg.QueryServer(), am.recordKeeper)
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/btcutil/base58"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/address"
+)
+
+// Types of the verification methods and services of the DID documents
+const (
+	VerificationMethodMultikey = "Multikey"
+
+	ServiceLinkedRecord = "LinkedRecord"
+)
+
+// JSON-LD contexts of the DID documents
+const (
+	ContextDID         = "https://www.w3.org/ns/did/v1"
+	ContextMultikey    = "https://w3id.org/security/multikey/v1"
+	multibaseBase58BTC = "z"
+)
+
+// Multicodec prefixes of the Multikey public keys, the secp256k1 keys being
+// compressed
+var (
+	multicodecSecp256k1 = []byte{0xe7, 0x01}
+	multicodecEd25519   = []byte{0xed, 0x01}
+)
+
+// ParseDID returns the account address of a did:fury DID
+func ParseDID(did string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(did, address.Bech32PrefixAccAddr) {
+		return nil, fmt.Errorf("invalid DID %s, expected an account address prefixed with %s", did, address.Bech32PrefixAccAddr)
+	}
+	return sdk.AccAddressFromBech32(did)
+}
+
+// NewVerificationMethod returns the Multikey verification method of a public
+// key, identified by the fragment of the DID
+func NewVerificationMethod(did, fragment string, pubKey cryptotypes.PubKey) (VerificationMethod, error) {
+	method := VerificationMethod{
+		Id:         did + "#" + fragment,
+		Type:       VerificationMethodMultikey,
+		Controller: did,
+	}
+	var multicodec []byte
+	switch pubKey.(type) {
+	case *secp256k1.PubKey:
+		multicodec = multicodecSecp256k1
+	case *ed25519.PubKey:
+		multicodec = multicodecEd25519
+	default:
+		return method, fmt.Errorf("unsupported public key type %s", pubKey.Type())
+	}
+	key := append(append([]byte{}, multicodec...), pubKey.Bytes()...)
+	method.PublicKeyMultibase = multibaseBase58BTC + base58.Encode(key)
+	return method, nil
+}
+
+type jsonLDDocument struct {
+	Context            []string                   `json:"@context"`
+	ID                 string                     `json:"id"`
+	Controller         []string                   `json:"controller,omitempty"`
+	VerificationMethod []jsonLDVerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication     []string                   `json:"authentication,omitempty"`
+	AssertionMethod    []string                   `json:"assertionMethod,omitempty"`
+	Service            []jsonLDService            `json:"service,omitempty"`
+}
+
+type jsonLDVerificationMethod struct {
+	ID                 string `json:"id"`
+	Type               string `json:"type"`
+	Controller         string `json:"controller"`
+	PublicKeyMultibase string `json:"publicKeyMultibase"`
+}
+
+type jsonLDService struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// MarshalJSONLD returns the JSON-LD representation of the document, as
+// specified by the W3C DID Core
+func (d Document) MarshalJSONLD() ([]byte, error) {
+	doc := jsonLDDocument{
+		Context:         []string{ContextDID},
+		ID:              d.Id,
+		Controller:      d.Controller,
+		Authentication:  d.Authentication,
+		AssertionMethod: d.AssertionMethod,
+	}
+
+	if len(d.VerificationMethods) > 0 {
+		doc.Context = append(doc.Context, ContextMultikey)
+	}
+	for _, method := range d.VerificationMethods {
+		doc.VerificationMethod = append(doc.VerificationMethod, jsonLDVerificationMethod{
+			ID:                 method.Id,
+			Type:               method.Type,
+			Controller:         method.Controller,
+			PublicKeyMultibase: method.PublicKeyMultibase,
+		})
+	}
+	for _, service := range d.Services {
+		doc.Service = append(doc.Service, jsonLDService{
+			ID:              service.Id,
+			Type:            service.Type,
+			ServiceEndpoint: service.ServiceEndpoint,
+		})
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
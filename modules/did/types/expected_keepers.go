@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+}
+
+// RecordKeeper defines the expected record keeper
+type RecordKeeper interface {
+	GetRecord(ctx sdk.Context, recordID []byte) (record recordtypes.Record, found bool)
+	RecordsIterator(ctx sdk.Context) sdk.Iterator
+}
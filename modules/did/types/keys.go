@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "did"
+
+	// StoreKey is the default store key for did
+	StoreKey = ModuleName
+)
+
+var (
+	RecordKey = []byte{0x01} // key of the index of the records by creator
+)
+
+// GetRecordKey returns the key of the record of the creator in the index of
+// the records by creator
+// VALUE: []byte{}
+func GetRecordKey(creator sdk.AccAddress, recordID []byte) []byte {
+	return append(GetRecordsSubspaceKey(creator), recordID...)
+}
+
+// GetRecordsSubspaceKey returns the key prefix of the records of the creator
+// in the index of the records by creator
+func GetRecordsSubspaceKey(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, RecordKey...), address.MustLengthPrefix(creator)...)
+}
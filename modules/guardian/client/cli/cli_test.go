@@ -141,4 +141,48 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
 	supersResp = respType.(*guardiantypes.QuerySupersResponse)
 	s.Require().Equal(1, len(supersResp.Supers))
+
+	//------test GetCmdBlockAddress()-------------
+	blockedAddr := sdk.AccAddress([]byte("blocked_address_____"))
+	args = []string{
+		fmt.Sprintf("--%s=%s", guardiancli.FlagAddress, blockedAddr.String()),
+		fmt.Sprintf("--%s=%s", guardiancli.FlagReason, "compromised"),
+
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	respType = proto.Message(&sdk.TxResponse{})
+
+	bz, err = guardiantestutil.BlockAddressExec(val.ClientCtx, addr.String(), args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp = respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code)
+
+	respType = proto.Message(&guardiantypes.QueryBlockedAddressesResponse{})
+	bz, err = guardiantestutil.QueryBlockedAddressesExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
+	blockedResp := respType.(*guardiantypes.QueryBlockedAddressesResponse)
+	s.Require().Equal(1, len(blockedResp.BlockedAddresses))
+	s.Require().Equal("compromised", blockedResp.BlockedAddresses[0].Reason)
+
+	//------test GetCmdUnblockAddress()-------------
+	args[1] = fmt.Sprintf("--%s=%s", guardiancli.FlagReason, "recovered")
+	respType = proto.Message(&sdk.TxResponse{})
+
+	bz, err = guardiantestutil.UnblockAddressExec(val.ClientCtx, addr.String(), args...)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp = respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code)
+
+	respType = proto.Message(&guardiantypes.QueryBlockedAddressesResponse{})
+	bz, err = guardiantestutil.QueryBlockedAddressesExec(clientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
+	blockedResp = respType.(*guardiantypes.QueryBlockedAddressesResponse)
+	s.Require().Equal(0, len(blockedResp.BlockedAddresses))
 }
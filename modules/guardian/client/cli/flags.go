@@ -8,16 +8,20 @@ import (
 const (
 	FlagAddress     = "address"
 	FlagDescription = "description"
+	FlagReason      = "reason"
 )
 
 // common flagsets to add to various functions
 var (
 	FsAddGuardian    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsDeleteGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBlocklist      = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsAddGuardian.String(FlagAddress, "", "bech32 encoded account address")
 	FsAddGuardian.String(FlagDescription, "", "description of account")
 	FsDeleteGuardian.String(FlagAddress, "", "bech32 encoded account address")
+	FsBlocklist.String(FlagAddress, "", "bech32 encoded account address")
+	FsBlocklist.String(FlagReason, "", "reason of the blocklist operation")
 }
@@ -24,6 +24,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetCmdQuerySupers(),
+		GetCmdQueryBlockedAddresses(),
+		GetCmdQueryBlockedAddress(),
 	)
 	return txCmd
 }
@@ -59,3 +61,63 @@ func GetCmdQuerySupers() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "all supper")
 	return cmd
 }
+
+// GetCmdQueryBlockedAddresses implements the query blocked addresses command.
+func GetCmdQueryBlockedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-addresses",
+		Short:   "Query for all blocked addresses",
+		Example: fmt.Sprintf("%s query guardian blocked-addresses", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockedAddresses(context.Background(), &types.QueryBlockedAddressesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all blocked addresses")
+	return cmd
+}
+
+// GetCmdQueryBlockedAddress implements the query blocked address command.
+func GetCmdQueryBlockedAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-address [address]",
+		Short:   "Query the blocklist entry of an address",
+		Example: fmt.Sprintf("%s query guardian blocked-address <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAddress(context.Background(), &types.QueryBlockedAddressRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BlockedAddress)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
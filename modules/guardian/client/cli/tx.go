@@ -25,6 +25,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdCreateSuper(),
 		GetCmdDeleteSuper(),
+		GetCmdBlockAddress(),
+		GetCmdUnblockAddress(),
 	)
 	return txCmd
 }
@@ -104,3 +106,77 @@ func GetCmdDeleteSuper() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdBlockAddress implements the block address command.
+func GetCmdBlockAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-address",
+		Short: "Add an address to the blocklist",
+		Example: fmt.Sprintf(
+			"%s tx guardian block-address --chain-id=<chain-id> --from=<key-name> --fees=0.3fury --address=<blocked address> --reason=<reason>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			reason, _ := cmd.Flags().GetString(FlagReason)
+			msg := types.NewMsgBlockAddress(pAddr, reason, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsBlocklist)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagReason)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnblockAddress implements the unblock address command.
+func GetCmdUnblockAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock-address",
+		Short: "Remove an address from the blocklist",
+		Example: fmt.Sprintf(
+			"%s tx guardian unblock-address --chain-id=<chain-id> --from=<key-name> --fees=0.3fury --address=<blocked address> --reason=<reason>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			reason, _ := cmd.Flags().GetString(FlagReason)
+			msg := types.NewMsgUnblockAddress(pAddr, reason, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsBlocklist)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagReason)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
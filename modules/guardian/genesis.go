@@ -18,6 +18,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	for _, super := range data.Supers {
 		keeper.AddSuper(ctx, super)
 	}
+	// Add blocked addresses
+	for _, blocked := range data.BlockedAddresses {
+		keeper.SetBlockedAddress(ctx, blocked)
+	}
 }
 
 // ExportGenesis outputs genesis data
@@ -31,7 +35,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(supers)
+	var blockedAddresses []types.BlockedAddress
+	k.IterateBlockedAddresses(
+		ctx,
+		func(blocked types.BlockedAddress) bool {
+			blockedAddresses = append(blockedAddresses, blocked)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(supers, blockedAddresses)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
@@ -45,5 +58,21 @@ func ValidateGenesis(data types.GenesisState) error {
 			return err
 		}
 	}
+	seen := make(map[string]bool, len(data.BlockedAddresses))
+	for _, blocked := range data.BlockedAddresses {
+		if _, err := sdk.AccAddressFromBech32(blocked.Address); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(blocked.BlockedBy); err != nil {
+			return err
+		}
+		if err := types.ValidateReason(blocked.Reason); err != nil {
+			return err
+		}
+		if seen[blocked.Address] {
+			return fmt.Errorf("duplicate blocked address %s", blocked.Address)
+		}
+		seen[blocked.Address] = true
+	}
 	return nil
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/furynet/furyhub/modules/guardian/types"
+)
+
+var _ bankkeeper.Keeper = SendRestrictedBankKeeper{}
+
+// SendRestrictedBankKeeper wraps the bank keeper so that blocked addresses
+// can't receive funds.
+//
+// The transfers between accounts are always restricted. The payouts of the
+// module accounts through SendCoinsFromModuleToAccount are restricted when
+// they result from a transaction, such as the IBC vouchers minted to the
+// receiver of a packet, whose acknowledgement then fails, the withdrawn
+// rewards or the claimed HTLCs. The payouts made by the begin and end
+// blockers stay exempt, since failing them would halt the chain:
+//
+//   - the gov deposit refunds of the proposals ending their periods
+//   - the expired HTLCs refunded to their senders
+//   - the unbondings maturing, which go through UndelegateCoinsFromModuleToAccount
+//   - the writes of the upgrade handlers and of the genesis
+//
+// Bank messages are checked by the ante handler, as the bank module keeps the
+// base keeper.
+type SendRestrictedBankKeeper struct {
+	bankkeeper.Keeper
+
+	gk Keeper
+}
+
+// NewSendRestrictedBankKeeper returns a bank keeper which honours the guardian blocklist
+func NewSendRestrictedBankKeeper(bk bankkeeper.Keeper, gk Keeper) SendRestrictedBankKeeper {
+	return SendRestrictedBankKeeper{
+		Keeper: bk,
+		gk:     gk,
+	}
+}
+
+// SendCoins rejects the transfer if the recipient is blocked
+func (k SendRestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.gk.IsBlocked(ctx, toAddr) {
+		return sdkerrors.Wrapf(types.ErrAddressBlocked, "%s is not allowed to receive funds", toAddr)
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// SendCoinsFromModuleToAccount rejects the payout if the recipient is blocked
+// and the payout results from a transaction, which the blockers don't run
+func (k SendRestrictedBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if len(ctx.TxBytes()) > 0 && k.gk.IsBlocked(ctx, recipientAddr) {
+		return sdkerrors.Wrapf(types.ErrAddressBlocked, "%s is not allowed to receive funds", recipientAddr)
+	}
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// InputOutputCoins rejects the transfer if any recipient is blocked
+func (k SendRestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, out := range outputs {
+		toAddr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if k.gk.IsBlocked(ctx, toAddr) {
+			return sdkerrors.Wrapf(types.ErrAddressBlocked, "%s is not allowed to receive funds", toAddr)
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/furynet/furyhub/modules/guardian/types"
+)
+
+// SetBlockedAddress adds the given entry to the blocklist
+func (k Keeper) SetBlockedAddress(ctx sdk.Context, blocked types.BlockedAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&blocked)
+	address, _ := sdk.AccAddressFromBech32(blocked.Address)
+	store.Set(types.GetBlockedAddressKey(address), bz)
+}
+
+// DeleteBlockedAddress removes the address from the blocklist
+func (k Keeper) DeleteBlockedAddress(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBlockedAddressKey(address))
+}
+
+// GetBlockedAddress retrieves the blocklist entry of the specified address
+func (k Keeper) GetBlockedAddress(ctx sdk.Context, addr sdk.AccAddress) (blocked types.BlockedAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetBlockedAddressKey(addr)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &blocked)
+		return blocked, true
+	}
+	return blocked, false
+}
+
+// IsBlocked returns true if the address is on the blocklist
+func (k Keeper) IsBlocked(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetBlockedAddressKey(addr))
+}
+
+// IterateBlockedAddresses iterates through all blocked addresses
+func (k Keeper) IterateBlockedAddresses(
+	ctx sdk.Context,
+	op func(blocked types.BlockedAddress) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBlockedAddressesSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var blocked types.BlockedAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &blocked)
+
+		if stop := op(blocked); stop {
+			break
+		}
+	}
+}
+
+// IsModuleAccount returns true if the address belongs to a module account,
+// module accounts can never be blocked
+func (k Keeper) IsModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// CanManageBlocklist returns true if the operator is a super or the authority
+func (k Keeper) CanManageBlocklist(ctx sdk.Context, operator sdk.AccAddress) bool {
+	return operator.String() == k.authority || k.Authorized(ctx, operator)
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/furynet/furyhub/modules/guardian/keeper"
+	"github.com/furynet/furyhub/modules/guardian/types"
+	minttypes "github.com/furynet/furyhub/modules/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestBlockAddress() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// only a super or the authority can manage the blocklist
+	_, err := msgServer.BlockAddress(goCtx, types.NewMsgBlockAddress(addrs[1], "compromised", addrs[0]))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("test", types.Ordinary, addrs[0], addrs[0]))
+	_, err = msgServer.BlockAddress(goCtx, types.NewMsgBlockAddress(addrs[1], "compromised", addrs[0]))
+	suite.NoError(err)
+	suite.True(suite.keeper.IsBlocked(suite.ctx, addrs[1]))
+
+	blocked, found := suite.keeper.GetBlockedAddress(suite.ctx, addrs[1])
+	suite.True(found)
+	suite.Equal("compromised", blocked.Reason)
+	suite.Equal(addrs[0].String(), blocked.BlockedBy)
+
+	_, err = msgServer.BlockAddress(goCtx, types.NewMsgBlockAddress(addrs[1], "compromised", addrs[0]))
+	suite.ErrorIs(err, types.ErrAddressBlocked)
+
+	// module accounts are exempt
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.BlockAddress(goCtx, types.NewMsgBlockAddress(feeCollector, "compromised", addrs[0]))
+	suite.ErrorIs(err, types.ErrBlockModuleAccount)
+
+	// the gov module account is able to unblock
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.UnblockAddress(goCtx, types.NewMsgUnblockAddress(addrs[1], "recovered", gov))
+	suite.NoError(err)
+	suite.False(suite.keeper.IsBlocked(suite.ctx, addrs[1]))
+
+	_, err = msgServer.UnblockAddress(goCtx, types.NewMsgUnblockAddress(addrs[1], "recovered", gov))
+	suite.ErrorIs(err, types.ErrAddressNotBlocked)
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddressCannotReceive() {
+	amt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.NoError(banktestutil.FundAccount(suite.app.BankKeeper, suite.ctx, addrs[0], amt.Add(amt...)))
+
+	suite.keeper.SetBlockedAddress(suite.ctx, types.NewBlockedAddress(addrs[1], "compromised", addrs[2], 1))
+
+	err := suite.app.BankKeeper.SendCoins(suite.ctx, addrs[0], addrs[1], amt)
+	suite.ErrorIs(err, types.ErrAddressBlocked)
+
+	err = suite.app.BankKeeper.InputOutputCoins(suite.ctx,
+		[]banktypes.Input{banktypes.NewInput(addrs[0], amt)},
+		[]banktypes.Output{banktypes.NewOutput(addrs[1], amt)},
+	)
+	suite.ErrorIs(err, types.ErrAddressBlocked)
+
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, addrs[0], addrs[2], amt))
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddressCannotReceiveFromModules() {
+	amt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, amt.Add(amt...)))
+
+	suite.keeper.SetBlockedAddress(suite.ctx, types.NewBlockedAddress(addrs[1], "compromised", addrs[2], 1))
+
+	// the payouts resulting from a transaction are rejected
+	txCtx := suite.ctx.WithTxBytes([]byte("tx"))
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(txCtx, minttypes.ModuleName, addrs[1], amt)
+	suite.ErrorIs(err, types.ErrAddressBlocked)
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(txCtx, minttypes.ModuleName, addrs[2], amt))
+
+	// the payouts of the blockers are exempt
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addrs[1], amt))
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddressCannotReceiveIBC() {
+	suite.keeper.SetBlockedAddress(suite.ctx, types.NewBlockedAddress(addrs[1], "compromised", addrs[2], 1))
+
+	ctx := suite.ctx.WithTxBytes([]byte("tx"))
+	transferModule := transfer.NewIBCModule(suite.app.TransferKeeper)
+	recvPacket := func(sequence uint64, receiver sdk.AccAddress) exported.Acknowledgement {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "sender", receiver.String())
+		packet := channeltypes.NewPacket(data.GetBytes(), sequence, "transfer", "channel-7", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+		return transferModule.OnRecvPacket(ctx, packet, nil)
+	}
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", "uatom")).IBCDenom()
+
+	// the acknowledgement of the vouchers sent to a blocked receiver fails
+	suite.False(recvPacket(1, addrs[1]).Success())
+	suite.True(suite.app.BankKeeper.GetBalance(ctx, addrs[1], voucher).IsZero())
+
+	suite.True(recvPacket(2, addrs[2]).Success())
+	suite.Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(ctx, addrs[2], voucher).Amount)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryBlockedAddresses() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	super := types.NewSuper("test", types.Genesis, addrs[0], addrs[0])
+	blocked := types.NewBlockedAddress(addrs[1], "compromised", addrs[0], 1)
+	suite.keeper.AddSuper(ctx, super)
+	suite.keeper.SetBlockedAddress(ctx, blocked)
+
+	blockedRes, err := queryClient.BlockedAddresses(gocontext.Background(), &types.QueryBlockedAddressesRequest{})
+	suite.NoError(err)
+	suite.Equal([]types.BlockedAddress{blocked}, blockedRes.BlockedAddresses)
+
+	blockedAddrRes, err := queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: addrs[1].String()})
+	suite.NoError(err)
+	suite.Equal(blocked, blockedAddrRes.BlockedAddress)
+
+	_, err = queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: addrs[2].String()})
+	suite.Error(err)
+
+	// blocked addresses don't leak into the supers query
+	supersRes, err := queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{})
+	suite.NoError(err)
+	suite.Equal([]types.Super{super}, supersRes.Supers)
+}
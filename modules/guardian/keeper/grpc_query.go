@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -21,7 +22,7 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 	var supers []types.Super
-	store := ctx.KVStore(k.storeKey)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSupersSubspaceKey())
 
 	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
 		var super types.Super
@@ -35,3 +36,44 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 
 	return &types.QuerySupersResponse{Supers: supers, Pagination: pageRes}, nil
 }
+
+// BlockedAddresses implements the Query/BlockedAddresses gRPC method
+func (k Keeper) BlockedAddresses(c context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var blockedAddresses []types.BlockedAddress
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBlockedAddressesSubspaceKey())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var blocked types.BlockedAddress
+		k.cdc.MustUnmarshal(value, &blocked)
+		blockedAddresses = append(blockedAddresses, blocked)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBlockedAddressesResponse{BlockedAddresses: blockedAddresses, Pagination: pageRes}, nil
+}
+
+// BlockedAddress implements the Query/BlockedAddress gRPC method
+func (k Keeper) BlockedAddress(c context.Context, req *types.QueryBlockedAddressRequest) (*types.QueryBlockedAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blocked, found := k.GetBlockedAddress(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "address %s is not blocked", req.Address)
+	}
+
+	return &types.QueryBlockedAddressResponse{BlockedAddress: blocked}, nil
+}
@@ -14,19 +14,31 @@ import (
 
 // Keeper of the guardian store
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
+	cdc           codec.Codec
+	storeKey      storetypes.StoreKey
+	accountKeeper types.AccountKeeper
+
+	// the address capable of managing the blocklist besides the supers,
+	// usually the gov module account
+	authority string
 }
 
 // NewKeeper returns a guardian keeper
-func NewKeeper(cdc codec.Codec, key storetypes.StoreKey) Keeper {
+func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, ak types.AccountKeeper, authority string) Keeper {
 	keeper := Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:      key,
+		cdc:           cdc,
+		accountKeeper: ak,
+		authority:     authority,
 	}
 	return keeper
 }
 
+// GetAuthority returns the address allowed to manage the blocklist besides the supers
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
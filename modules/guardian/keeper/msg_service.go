@@ -96,3 +96,81 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 
 	return &types.MsgDeleteSuperResponse{}, nil
 }
+
+func (m msgServer) BlockAddress(goCtx context.Context, msg *types.MsgBlockAddress) (*types.MsgBlockAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.CanManageBlocklist(ctx, operator) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+	if m.Keeper.IsModuleAccount(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrBlockModuleAccount, msg.Address)
+	}
+	if m.Keeper.IsBlocked(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAddressBlocked, msg.Address)
+	}
+
+	blocked := types.NewBlockedAddress(address, msg.Reason, operator, ctx.BlockHeight())
+	m.Keeper.SetBlockedAddress(ctx, blocked)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeBlockAddress,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgBlockAddressResponse{}, nil
+}
+
+func (m msgServer) UnblockAddress(goCtx context.Context, msg *types.MsgUnblockAddress) (*types.MsgUnblockAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.CanManageBlocklist(ctx, operator) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+	if !m.Keeper.IsBlocked(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAddressNotBlocked, msg.Address)
+	}
+
+	m.Keeper.DeleteBlockedAddress(ctx, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeUnblockAddress,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgUnblockAddressResponse{}, nil
+}
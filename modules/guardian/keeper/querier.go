@@ -16,6 +16,8 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		switch path[0] {
 		case types.QuerySupers:
 			return querySupers(ctx, k, legacyQuerierCdc)
+		case types.QueryBlockedAddresses:
+			return queryBlockedAddresses(ctx, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -38,3 +40,20 @@ func querySupers(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino)
 	}
 	return bz, nil
 }
+
+func queryBlockedAddresses(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var blockedAddresses []types.BlockedAddress
+	k.IterateBlockedAddresses(
+		ctx,
+		func(blocked types.BlockedAddress) bool {
+			blockedAddresses = append(blockedAddresses, blocked)
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, blockedAddresses)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
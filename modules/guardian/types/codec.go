@@ -13,12 +13,16 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddSuper{}, "gridiron/guardian/MsgAddSuper", nil)
 	cdc.RegisterConcrete(&MsgDeleteSuper{}, "gridiron/guardian/MsgDeleteSuper", nil)
+	cdc.RegisterConcrete(&MsgBlockAddress{}, "gridiron/guardian/MsgBlockAddress", nil)
+	cdc.RegisterConcrete(&MsgUnblockAddress{}, "gridiron/guardian/MsgUnblockAddress", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddSuper{},
 		&MsgDeleteSuper{},
+		&MsgBlockAddress{},
+		&MsgUnblockAddress{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
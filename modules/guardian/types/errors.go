@@ -10,4 +10,7 @@ var (
 	ErrUnknownSuper       = sdkerrors.Register(ModuleName, 3, "unknown super")
 	ErrSuperExists        = sdkerrors.Register(ModuleName, 4, "super already exists")
 	ErrDeleteGenesisSuper = sdkerrors.Register(ModuleName, 5, "can't delete genesis super")
+	ErrAddressBlocked     = sdkerrors.Register(ModuleName, 6, "address is blocked")
+	ErrAddressNotBlocked  = sdkerrors.Register(ModuleName, 7, "address is not blocked")
+	ErrBlockModuleAccount = sdkerrors.Register(ModuleName, 8, "can't block module account")
 )
@@ -3,12 +3,17 @@ package types
 
 // guardian module event types
 const (
-	EventTypeAddSuper    = "add_super"
-	EventTypeDeleteSuper = "delete_super"
+	EventTypeAddSuper       = "add_super"
+	EventTypeDeleteSuper    = "delete_super"
+	EventTypeBlockAddress   = "block_address"
+	EventTypeUnblockAddress = "unblock_address"
 
 	AttributeKeySuperAddress = "address"
 	AttributeKeyAddedBy      = "added_by"
 	AttributeKeyDeletedBy    = "deleted_by"
+	AttributeKeyAddress      = "address"
+	AttributeKeyReason       = "reason"
+	AttributeKeyOperator     = "operator"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,9 +1,10 @@
 package types
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(supers []Super) *GenesisState {
+func NewGenesisState(supers []Super, blockedAddresses []BlockedAddress) *GenesisState {
 	return &GenesisState{
-		Supers: supers,
+		Supers:           supers,
+		BlockedAddresses: blockedAddresses,
 	}
 }
 
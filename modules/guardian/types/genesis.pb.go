@@ -25,7 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the guardian module's genesis state
 type GenesisState struct {
-	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Supers           []Super          `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	BlockedAddresses []BlockedAddress `protobuf:"bytes,2,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses" yaml:"blocked_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +69,13 @@ func (m *GenesisState) GetSupers() []Super {
 	return nil
 }
 
+func (m *GenesisState) GetBlockedAddresses() []BlockedAddress {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gridiron.guardian.GenesisState")
 }
@@ -75,20 +83,24 @@ func init() {
 func init() { proto.RegisterFile("guardian/genesis.proto", fileDescriptor_5203106ad1456439) }
 
 var fileDescriptor_5203106ad1456439 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
+	// 259 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2f, 0x4d, 0x2c,
 	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x12, 0x4c, 0x2f, 0xca, 0x4c, 0xc9, 0x2c, 0xca, 0xcf, 0xd3, 0x83, 0x29, 0x90,
 	0x12, 0x47, 0x28, 0x85, 0x32, 0x20, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d,
-	0x10, 0x0b, 0x22, 0xaa, 0xe4, 0xc6, 0xc5, 0xe3, 0x0e, 0x31, 0x32, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x8c, 0x8b, 0xad, 0xb8, 0xb4, 0x20, 0xb5, 0xa8, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb,
-	0x48, 0x42, 0x0f, 0xc3, 0x0a, 0xbd, 0x60, 0x90, 0x02, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82,
-	0xa0, 0xaa, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x22,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x66, 0x96, 0x6e, 0x55, 0x7e,
-	0x5e, 0x2a, 0x9c, 0xa7, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x77, 0xb1, 0x7e, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x89, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f,
-	0xe5, 0xed, 0xc1, 0xfe, 0x00, 0x00, 0x00,
+	0x10, 0x0b, 0x22, 0xaa, 0xb4, 0x83, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x66, 0x70, 0x49, 0x62, 0x49,
+	0xaa, 0x90, 0x19, 0x17, 0x5b, 0x71, 0x69, 0x41, 0x6a, 0x51, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06,
+	0xb7, 0x91, 0x84, 0x1e, 0x86, 0x1d, 0x7a, 0xc1, 0x20, 0x05, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
+	0x04, 0x41, 0x55, 0x0b, 0x15, 0x70, 0x09, 0x26, 0xe5, 0xe4, 0x27, 0x67, 0xa7, 0xa6, 0xc4, 0x27,
+	0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0xa7, 0x16, 0x4b, 0x30, 0x81, 0x8d, 0x50, 0xc4, 0x62, 0x84,
+	0x13, 0x44, 0xad, 0x23, 0x44, 0xa9, 0x93, 0x02, 0xc8, 0xac, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13,
+	0x73, 0x73, 0xac, 0x94, 0x30, 0x4c, 0x52, 0x0a, 0x12, 0x48, 0x42, 0xd1, 0x91, 0x5a, 0xec, 0xe4,
+	0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
+	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19,
+	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x69, 0xa5, 0x45, 0x95, 0x79, 0xa9, 0x25, 0x60, 0x3a,
+	0xa3, 0x34, 0x49, 0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0x1e, 0x3a, 0xfa, 0x25, 0x95,
+	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xe0, 0x30, 0x06, 0x0c, 0x00, 0x8b, 0x67, 0x49, 0x92, 0x6a,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +123,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Supers) > 0 {
 		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +177,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, e := range m.BlockedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -223,6 +255,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, BlockedAddress{})
+			if err := m.BlockedAddresses[len(m.BlockedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -120,36 +120,111 @@ func (m *Super) GetAddedBy() string {
 	return ""
 }
 
+// BlockedAddress defines an account which is frozen by a guardian or by governance
+type BlockedAddress struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlockedBy string `protobuf:"bytes,3,opt,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty" yaml:"blocked_by"`
+	Height    int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlockedAddress) Reset()         { *m = BlockedAddress{} }
+func (m *BlockedAddress) String() string { return proto.CompactTextString(m) }
+func (*BlockedAddress) ProtoMessage()    {}
+func (*BlockedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+func (m *BlockedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAddress.Merge(m, src)
+}
+func (m *BlockedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAddress proto.InternalMessageInfo
+
+func (m *BlockedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetBlockedBy() string {
+	if m != nil {
+		return m.BlockedBy
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gridiron.guardian.AccountType", AccountType_name, AccountType_value)
 	proto.RegisterType((*Super)(nil), "gridiron.guardian.Super")
+	proto.RegisterType((*BlockedAddress)(nil), "gridiron.guardian.BlockedAddress")
 }
 
 func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
 
 var fileDescriptor_07c8fad859e95e75 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x87, 0x31, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xd3,
-	0x8b, 0x32, 0x53, 0x32, 0x8b, 0xf2, 0xf3, 0xf4, 0x60, 0x12, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9,
-	0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2, 0x50, 0x69, 0x17, 0x23, 0x17, 0x6b, 0x70, 0x69, 0x41, 0x6a,
-	0x91, 0x90, 0x02, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0xb2, 0x90, 0x50, 0x14, 0x17, 0x4f, 0x62, 0x72, 0x72,
-	0x7e, 0x69, 0x5e, 0x49, 0x7c, 0x49, 0x65, 0x41, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x9f, 0x91,
-	0x9c, 0x1e, 0x86, 0x5d, 0x7a, 0x8e, 0x10, 0x65, 0x21, 0x95, 0x05, 0xa9, 0x4e, 0xe2, 0x9f, 0xee,
-	0xc9, 0x0b, 0x57, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x21, 0xeb, 0x56, 0x0a, 0xe2, 0x4e, 0x44, 0xa8,
-	0x12, 0x92, 0xe0, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x06, 0xdb, 0x0c,
-	0xe3, 0x0a, 0x49, 0x72, 0x71, 0x24, 0xa6, 0xa4, 0xa4, 0xa6, 0xc4, 0x27, 0x55, 0x4a, 0xb0, 0xc0,
-	0xa5, 0x52, 0x53, 0x9c, 0x2a, 0xb5, 0x3c, 0xb9, 0xb8, 0x1d, 0x51, 0xcd, 0x70, 0x77, 0xf5, 0x73,
-	0x0d, 0xf6, 0x0c, 0x16, 0x60, 0x90, 0xe2, 0xee, 0x9a, 0xab, 0xc0, 0xee, 0x9e, 0x9a, 0x97, 0x5a,
-	0x9c, 0x59, 0x2c, 0x24, 0xc5, 0xc5, 0xe1, 0x1f, 0xe4, 0xe2, 0xe9, 0xe7, 0x18, 0x14, 0x29, 0xc0,
-	0x28, 0xc5, 0xd3, 0x35, 0x57, 0x81, 0xc3, 0xbf, 0x28, 0x25, 0x33, 0x2f, 0xb1, 0xa8, 0x52, 0x8a,
-	0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x53, 0xd0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x7c, 0xaa,
-	0x5b, 0x95, 0x9f, 0x97, 0x0a, 0xe7, 0xe9, 0xe7, 0xe6, 0xa7, 0x94, 0xe6, 0xa4, 0x16, 0xc3, 0x23,
-	0x41, 0x1f, 0xe4, 0xc1, 0xe2, 0x24, 0x36, 0x70, 0x10, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xec, 0xe0, 0x58, 0xf2, 0xa6, 0x01, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x3d, 0x6e, 0xdb, 0x30,
+	0x18, 0x15, 0x9b, 0x34, 0xb1, 0xa9, 0x20, 0x48, 0xd4, 0x9f, 0xa8, 0x1a, 0x18, 0x41, 0x53, 0xd0,
+	0x41, 0x42, 0x7f, 0xa6, 0x6e, 0x12, 0x1a, 0x04, 0x46, 0x81, 0x04, 0x90, 0xbb, 0x34, 0x4b, 0x40,
+	0x89, 0xac, 0x44, 0xd4, 0x26, 0x05, 0x52, 0x1a, 0x78, 0x83, 0x22, 0x93, 0x2f, 0xe0, 0xa9, 0xb7,
+	0xe8, 0x09, 0x3a, 0x7a, 0xec, 0x64, 0x14, 0xf6, 0x0d, 0x7c, 0x82, 0x42, 0x3f, 0xfe, 0x43, 0x26,
+	0x7d, 0x4f, 0xef, 0xf1, 0xbd, 0xc7, 0x1f, 0x78, 0x91, 0x55, 0x58, 0x12, 0x86, 0x79, 0xb0, 0x1e,
+	0xfc, 0x42, 0x8a, 0x52, 0x58, 0xe7, 0x99, 0x64, 0x84, 0x49, 0xc1, 0xfd, 0x35, 0xe1, 0xbc, 0xcc,
+	0x44, 0x26, 0x1a, 0x36, 0xa8, 0xa7, 0x56, 0xe8, 0xfd, 0x06, 0xf0, 0xf9, 0xb0, 0x2a, 0xa8, 0xb4,
+	0x5c, 0x68, 0x12, 0xaa, 0x52, 0xc9, 0x8a, 0x92, 0x09, 0x6e, 0x03, 0x17, 0x5c, 0xf5, 0xe3, 0xdd,
+	0x5f, 0xd6, 0x3d, 0x3c, 0xc1, 0x69, 0x2a, 0x2a, 0x5e, 0x3e, 0x94, 0xba, 0xa0, 0xf6, 0x33, 0x17,
+	0x5c, 0x9d, 0xbe, 0x47, 0xfe, 0x93, 0x2c, 0x3f, 0x6c, 0x65, 0x5f, 0x75, 0x41, 0xa3, 0x8b, 0xd5,
+	0xfc, 0xf2, 0x85, 0xc6, 0xe3, 0xd1, 0x27, 0x6f, 0x77, 0xb5, 0x17, 0x9b, 0x78, 0xab, 0xb2, 0x6c,
+	0x78, 0x8c, 0x09, 0x91, 0x54, 0x29, 0xfb, 0xa0, 0x49, 0x5e, 0x43, 0xeb, 0x0d, 0xec, 0x61, 0x42,
+	0x28, 0x79, 0x48, 0xb4, 0x7d, 0xb8, 0xa1, 0x28, 0x89, 0xb4, 0x37, 0x01, 0xf0, 0x34, 0x1a, 0x89,
+	0xf4, 0x07, 0x25, 0x61, 0xa7, 0xde, 0xf1, 0x01, 0xfb, 0x3e, 0xaf, 0xe1, 0x91, 0xa4, 0x58, 0x09,
+	0xde, 0xf4, 0xee, 0xc7, 0x1d, 0xb2, 0x3e, 0x42, 0x98, 0xb4, 0x1e, 0x75, 0x42, 0x13, 0x1e, 0xbd,
+	0x5a, 0xcd, 0x2f, 0xcf, 0xdb, 0xce, 0x5b, 0xce, 0x8b, 0xfb, 0x1d, 0x88, 0x74, 0xed, 0x96, 0x53,
+	0x96, 0xe5, 0x65, 0xd3, 0xe9, 0x20, 0xee, 0xd0, 0xdb, 0x01, 0x34, 0xc3, 0xfd, 0x6d, 0xdd, 0x5c,
+	0xdf, 0x5e, 0x0f, 0x07, 0xc3, 0x33, 0xc3, 0x31, 0x1f, 0xa7, 0xee, 0xf1, 0x0d, 0xe5, 0x54, 0x31,
+	0x65, 0x39, 0xb0, 0x77, 0x17, 0x7f, 0x1e, 0xdc, 0x86, 0xf1, 0xb7, 0x33, 0xe0, 0x9c, 0x3c, 0x4e,
+	0xdd, 0xde, 0x9d, 0x24, 0x8c, 0x63, 0xa9, 0x9d, 0xc3, 0x9f, 0xbf, 0x90, 0x11, 0x7d, 0xf9, 0xb3,
+	0x40, 0x60, 0xb6, 0x40, 0xe0, 0xdf, 0x02, 0x81, 0xc9, 0x12, 0x19, 0xb3, 0x25, 0x32, 0xfe, 0x2e,
+	0x91, 0x71, 0xff, 0x2e, 0x63, 0x65, 0x5e, 0x25, 0x7e, 0x2a, 0xc6, 0xc1, 0xf7, 0x4a, 0x6a, 0x4e,
+	0xcb, 0xe6, 0x9b, 0x57, 0x49, 0x30, 0x16, 0xa4, 0x1a, 0x51, 0xb5, 0x79, 0x10, 0x41, 0x7d, 0xd8,
+	0x2a, 0x39, 0x6a, 0xae, 0xfb, 0xc3, 0xff, 0x01, 0x00, 0x9d, 0x66, 0x87, 0xb5, 0x32, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Super) Marshal() (dAtA []byte, err error) {
@@ -201,6 +276,55 @@ func (m *Super) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockedBy) > 0 {
+		i -= len(m.BlockedBy)
+		copy(dAtA[i:], m.BlockedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.BlockedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGuardian(v)
 	base := offset
@@ -236,6 +360,30 @@ func (m *Super) Size() (n int) {
 	return n
 }
 
+func (m *BlockedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.BlockedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	return n
+}
+
 func sovGuardian(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -407,6 +555,171 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGuardian(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
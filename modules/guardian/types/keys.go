@@ -19,11 +19,13 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the guardian querier
-	QuerySupers = "supers"
+	QuerySupers           = "supers"
+	QueryBlockedAddresses = "blocked_addresses"
 )
 
 var (
-	SuperKey = []byte{0x00} // super key
+	SuperKey          = []byte{0x00} // super key
+	BlockedAddressKey = []byte{0x01} // blocked address key
 )
 
 // GetSuperKey returns super key bytes
@@ -35,3 +37,13 @@ func GetSuperKey(addr sdk.AccAddress) []byte {
 func GetSupersSubspaceKey() []byte {
 	return SuperKey
 }
+
+// GetBlockedAddressKey returns blocked address key bytes
+func GetBlockedAddressKey(addr sdk.AccAddress) []byte {
+	return append(BlockedAddressKey, addr.Bytes()...)
+}
+
+// GetBlockedAddressesSubspaceKey returns the key for getting all blocked addresses from the store
+func GetBlockedAddressesSubspaceKey() []byte {
+	return BlockedAddressKey
+}
@@ -1,18 +1,27 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	TypeMsgAddSuper    = "add_super"    // type for MsgAddSuper
-	TypeMsgDeleteSuper = "delete_super" // type for MsgDeleteSuper
+	TypeMsgAddSuper       = "add_super"       // type for MsgAddSuper
+	TypeMsgDeleteSuper    = "delete_super"    // type for MsgDeleteSuper
+	TypeMsgBlockAddress   = "block_address"   // type for MsgBlockAddress
+	TypeMsgUnblockAddress = "unblock_address" // type for MsgUnblockAddress
+
+	// MaxReasonLength defines the max length of the blocklist reason
+	MaxReasonLength = 280
 )
 
 var (
 	_ sdk.Msg = &MsgAddSuper{}
 	_ sdk.Msg = &MsgDeleteSuper{}
+	_ sdk.Msg = &MsgBlockAddress{}
+	_ sdk.Msg = &MsgUnblockAddress{}
 )
 
 // NewMsgAddSuper constructs a MsgAddSuper
@@ -117,3 +126,104 @@ func (msg MsgAddSuper) EnsureLength() error {
 	}
 	return nil
 }
+
+// ______________________________________________________________________
+
+// NewMsgBlockAddress constructs a MsgBlockAddress
+func NewMsgBlockAddress(address sdk.AccAddress, reason string, operator sdk.AccAddress) *MsgBlockAddress {
+	return &MsgBlockAddress{
+		Address:  address.String(),
+		Reason:   reason,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgBlockAddress) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgBlockAddress) Type() string { return TypeMsgBlockAddress }
+
+// GetSignBytes implements Msg.
+func (msg MsgBlockAddress) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgBlockAddress) ValidateBasic() error {
+	return validateBlocklistMsg(msg.Address, msg.Reason, msg.Operator)
+}
+
+// GetSigners implements Msg.
+func (msg MsgBlockAddress) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgUnblockAddress constructs a MsgUnblockAddress
+func NewMsgUnblockAddress(address sdk.AccAddress, reason string, operator sdk.AccAddress) *MsgUnblockAddress {
+	return &MsgUnblockAddress{
+		Address:  address.String(),
+		Reason:   reason,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUnblockAddress) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUnblockAddress) Type() string { return TypeMsgUnblockAddress }
+
+// GetSignBytes implements Msg.
+func (msg MsgUnblockAddress) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUnblockAddress) ValidateBasic() error {
+	return validateBlocklistMsg(msg.Address, msg.Reason, msg.Operator)
+}
+
+// GetSigners implements Msg.
+func (msg MsgUnblockAddress) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func validateBlocklistMsg(address, reason, operator string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return ValidateReason(reason)
+}
+
+// ValidateReason validates the reason of a blocklist operation
+func ValidateReason(reason string) error {
+	if len(strings.TrimSpace(reason)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "reason missing")
+	}
+	if len(reason) > MaxReasonLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason length; got: %d, max: %d", len(reason), MaxReasonLength)
+	}
+	return nil
+}
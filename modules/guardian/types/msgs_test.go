@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -144,3 +145,40 @@ func TestMsgDeleteSuperValidation(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+// test MsgBlockAddress and MsgUnblockAddress
+// ----------------------------------------------
+
+func TestMsgBlockAddressValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        sdk.Msg
+	}{
+		{"pass", true, NewMsgBlockAddress(testAddr, "compromised", sender)},
+		{"missing reason", false, NewMsgBlockAddress(testAddr, " ", sender)},
+		{"reason too long", false, NewMsgBlockAddress(testAddr, strings.Repeat("r", MaxReasonLength+1), sender)},
+		{"invalid Address", false, NewMsgBlockAddress(nilAddr, "compromised", sender)},
+		{"invalid Operator", false, NewMsgBlockAddress(testAddr, "compromised", nilAddr)},
+		{"unblock pass", true, NewMsgUnblockAddress(testAddr, "recovered", sender)},
+		{"unblock missing reason", false, NewMsgUnblockAddress(testAddr, "", sender)},
+		{"unblock invalid Operator", false, NewMsgUnblockAddress(testAddr, "recovered", nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgBlockAddressGetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{sender}, NewMsgBlockAddress(testAddr, "compromised", sender).GetSigners())
+	require.Equal(t, []sdk.AccAddress{sender}, NewMsgUnblockAddress(testAddr, "recovered", sender).GetSigners())
+}
@@ -129,38 +129,241 @@ func (m *QuerySupersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryBlockedAddressesRequest is request type for the Query/BlockedAddresses RPC method
+type QueryBlockedAddressesRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesRequest) Reset()         { *m = QueryBlockedAddressesRequest{} }
+func (m *QueryBlockedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{2}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedAddressesResponse is response type for the Query/BlockedAddresses RPC method
+type QueryBlockedAddressesResponse struct {
+	BlockedAddresses []BlockedAddress    `protobuf:"bytes,1,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesResponse) Reset()         { *m = QueryBlockedAddressesResponse{} }
+func (m *QueryBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{3}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesResponse) GetBlockedAddresses() []BlockedAddress {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *QueryBlockedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedAddressRequest is request type for the Query/BlockedAddress RPC method
+type QueryBlockedAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBlockedAddressRequest) Reset()         { *m = QueryBlockedAddressRequest{} }
+func (m *QueryBlockedAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{4}
+}
+func (m *QueryBlockedAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryBlockedAddressResponse is response type for the Query/BlockedAddress RPC method
+type QueryBlockedAddressResponse struct {
+	BlockedAddress BlockedAddress `protobuf:"bytes,1,opt,name=blocked_address,json=blockedAddress,proto3" json:"blocked_address"`
+}
+
+func (m *QueryBlockedAddressResponse) Reset()         { *m = QueryBlockedAddressResponse{} }
+func (m *QueryBlockedAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{5}
+}
+func (m *QueryBlockedAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressResponse) GetBlockedAddress() BlockedAddress {
+	if m != nil {
+		return m.BlockedAddress
+	}
+	return BlockedAddress{}
+}
+
 func init() {
 	proto.RegisterType((*QuerySupersRequest)(nil), "gridiron.guardian.QuerySupersRequest")
 	proto.RegisterType((*QuerySupersResponse)(nil), "gridiron.guardian.QuerySupersResponse")
+	proto.RegisterType((*QueryBlockedAddressesRequest)(nil), "gridiron.guardian.QueryBlockedAddressesRequest")
+	proto.RegisterType((*QueryBlockedAddressesResponse)(nil), "gridiron.guardian.QueryBlockedAddressesResponse")
+	proto.RegisterType((*QueryBlockedAddressRequest)(nil), "gridiron.guardian.QueryBlockedAddressRequest")
+	proto.RegisterType((*QueryBlockedAddressResponse)(nil), "gridiron.guardian.QueryBlockedAddressResponse")
 }
 
 func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
 
 var fileDescriptor_20cf24f8e5be2110 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4a, 0x2b, 0x31,
-	0x18, 0xc5, 0x27, 0xbd, 0xf7, 0x76, 0x91, 0xae, 0x6e, 0x6e, 0xe1, 0xd6, 0x2a, 0x63, 0x2d, 0x58,
-	0x8b, 0x60, 0x42, 0x2b, 0x88, 0xeb, 0x2e, 0x74, 0xab, 0xe3, 0x4e, 0xdc, 0x64, 0xda, 0x10, 0x07,
-	0xda, 0x7c, 0xd3, 0x49, 0x46, 0x68, 0x97, 0xfa, 0x02, 0x82, 0xe0, 0x33, 0x75, 0x59, 0x70, 0xe3,
-	0x4a, 0xa4, 0xf5, 0x41, 0xa4, 0xc9, 0xf4, 0x1f, 0x15, 0xba, 0x0b, 0x39, 0xbf, 0x73, 0xbe, 0x93,
-	0x7c, 0xb8, 0x28, 0x53, 0x9e, 0x74, 0x22, 0xae, 0x58, 0x3f, 0x15, 0xc9, 0x80, 0xc6, 0x09, 0x18,
-	0x20, 0x7f, 0x65, 0x12, 0x75, 0xa2, 0x04, 0x14, 0x9d, 0xcb, 0xe5, 0xa2, 0x04, 0x09, 0x56, 0x65,
-	0xb3, 0x93, 0x03, 0xcb, 0xff, 0x17, 0xf6, 0xf9, 0x21, 0x13, 0xf6, 0x24, 0x80, 0xec, 0x0a, 0xc6,
-	0xe3, 0x88, 0x71, 0xa5, 0xc0, 0x70, 0x13, 0x81, 0xd2, 0x99, 0x7a, 0xdc, 0x06, 0xdd, 0x03, 0xcd,
-	0x42, 0xae, 0x85, 0x1b, 0xcc, 0x1e, 0x1a, 0xa1, 0x30, 0xbc, 0xc1, 0x62, 0x2e, 0x23, 0x65, 0x61,
-	0xc7, 0x56, 0xef, 0x30, 0xb9, 0x9e, 0x11, 0x37, 0x69, 0x2c, 0x12, 0x1d, 0x88, 0x7e, 0x2a, 0xb4,
-	0x21, 0x17, 0x18, 0x2f, 0xc9, 0x12, 0xaa, 0xa0, 0x7a, 0xa1, 0x59, 0xa3, 0x2e, 0x96, 0xce, 0x62,
-	0xa9, 0x7b, 0x4f, 0x16, 0x4b, 0xaf, 0xb8, 0x14, 0x99, 0x37, 0x58, 0x71, 0x56, 0x5f, 0x11, 0xfe,
-	0xb7, 0x16, 0xaf, 0x63, 0x50, 0x5a, 0x90, 0x33, 0x9c, 0xd7, 0xf6, 0xa6, 0x84, 0x2a, 0xbf, 0xea,
-	0x85, 0x66, 0x89, 0x6e, 0x7c, 0x09, 0xb5, 0x96, 0xd6, 0xef, 0xd1, 0xc7, 0xbe, 0x17, 0x64, 0x34,
-	0xb9, 0x5c, 0xeb, 0x95, 0xb3, 0xbd, 0x8e, 0xb6, 0xf6, 0x72, 0x43, 0x57, 0x8b, 0x35, 0x9f, 0x10,
-	0xfe, 0x63, 0x8b, 0x91, 0x21, 0xce, 0xbb, 0x72, 0xe4, 0xf0, 0x87, 0x12, 0x9b, 0x7f, 0x53, 0xae,
-	0x6d, 0xc3, 0xdc, 0xb8, 0xea, 0xc1, 0xe3, 0xdb, 0xd7, 0x4b, 0x6e, 0x97, 0xec, 0xb0, 0x39, 0xbf,
-	0xd8, 0x22, 0x73, 0xcf, 0x69, 0x05, 0xa3, 0x89, 0x8f, 0xc6, 0x13, 0x1f, 0x7d, 0x4e, 0x7c, 0xf4,
-	0x3c, 0xf5, 0xbd, 0xf1, 0xd4, 0xf7, 0xde, 0xa7, 0xbe, 0x77, 0x7b, 0x2e, 0x23, 0x73, 0x9f, 0x86,
-	0xb4, 0x0d, 0xbd, 0x85, 0xfd, 0x64, 0x08, 0x4a, 0x2c, 0xc3, 0x7a, 0xd0, 0x49, 0xbb, 0x42, 0x2f,
-	0x43, 0xcd, 0x20, 0x16, 0x3a, 0xcc, 0xdb, 0xbd, 0x9e, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x86,
-	0x24, 0xd8, 0x94, 0x7b, 0x02, 0x00, 0x00,
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x2d, 0x04, 0x31, 0x95, 0x4a, 0xbb, 0x54, 0x22, 0xb8, 0xc5, 0xb4, 0x11, 0x84,
+	0x0a, 0xc1, 0x6e, 0x13, 0xa4, 0xdc, 0xc9, 0x01, 0x0e, 0x5c, 0x4a, 0xe0, 0x84, 0x90, 0xd0, 0x3a,
+	0xde, 0x6e, 0x2d, 0x12, 0xaf, 0xeb, 0xb5, 0x91, 0x02, 0xe2, 0xc2, 0x13, 0x20, 0x21, 0xf1, 0x02,
+	0x1c, 0x78, 0x0b, 0xce, 0x3d, 0x56, 0xea, 0x85, 0x13, 0x42, 0x09, 0x0f, 0x82, 0xb2, 0xbb, 0x6e,
+	0xeb, 0xc4, 0x51, 0x7d, 0xc8, 0x29, 0x4e, 0xe6, 0x9f, 0x7f, 0xbe, 0xf9, 0x33, 0x09, 0x6c, 0x88,
+	0x94, 0xc5, 0x7e, 0xc0, 0x42, 0x7a, 0x94, 0xf2, 0x78, 0x48, 0xa2, 0x58, 0x26, 0x12, 0xaf, 0x8b,
+	0x38, 0xf0, 0x83, 0x58, 0x86, 0x24, 0x2b, 0x3b, 0x1b, 0x42, 0x0a, 0xa9, 0xab, 0x74, 0xf2, 0x64,
+	0x84, 0xce, 0xad, 0xb3, 0xf6, 0xec, 0xc1, 0x16, 0xb6, 0x84, 0x94, 0xa2, 0xcf, 0x29, 0x8b, 0x02,
+	0xca, 0xc2, 0x50, 0x26, 0x2c, 0x09, 0x64, 0xa8, 0x6c, 0xf5, 0x61, 0x4f, 0xaa, 0x81, 0x54, 0xd4,
+	0x63, 0x8a, 0x9b, 0xc1, 0xf4, 0x43, 0xd3, 0xe3, 0x09, 0x6b, 0xd2, 0x88, 0x89, 0x20, 0xd4, 0x62,
+	0xa3, 0xad, 0xbf, 0x05, 0xfc, 0x72, 0xa2, 0x78, 0x95, 0x46, 0x3c, 0x56, 0x5d, 0x7e, 0x94, 0x72,
+	0x95, 0xe0, 0x67, 0x00, 0xe7, 0xca, 0x1a, 0xda, 0x46, 0xbb, 0x2b, 0xad, 0x06, 0x31, 0xb6, 0x64,
+	0x62, 0x4b, 0xcc, 0x3e, 0xd6, 0x96, 0xec, 0x33, 0xc1, 0x6d, 0x6f, 0xf7, 0x42, 0x67, 0xfd, 0x3b,
+	0x82, 0x9b, 0x39, 0x7b, 0x15, 0xc9, 0x50, 0x71, 0xdc, 0x86, 0xaa, 0xd2, 0x9f, 0xd4, 0xd0, 0xf6,
+	0xf2, 0xee, 0x4a, 0xab, 0x46, 0x66, 0x22, 0x21, 0xba, 0xa5, 0x73, 0xe5, 0xf8, 0xcf, 0xdd, 0x4a,
+	0xd7, 0xaa, 0xf1, 0xf3, 0x1c, 0xd7, 0x92, 0xe6, 0x7a, 0x70, 0x29, 0x97, 0x19, 0x9a, 0x03, 0x3b,
+	0x80, 0x2d, 0xcd, 0xd5, 0xe9, 0xcb, 0xde, 0x7b, 0xee, 0x3f, 0xf5, 0xfd, 0x98, 0x2b, 0xc5, 0x17,
+	0x1e, 0xc0, 0x2f, 0x04, 0x77, 0xe6, 0x0c, 0xb2, 0x51, 0xbc, 0x86, 0x75, 0xcf, 0xd4, 0xde, 0xb1,
+	0xac, 0x68, 0x53, 0xd9, 0x29, 0x48, 0x25, 0xef, 0x63, 0xe3, 0x59, 0xf3, 0xa6, 0xdc, 0x17, 0x17,
+	0x54, 0x1b, 0x9c, 0x02, 0xfe, 0x2c, 0xa6, 0x1a, 0x5c, 0xb3, 0xd0, 0x3a, 0xa3, 0xeb, 0xdd, 0xec,
+	0x6d, 0x5d, 0xc2, 0x66, 0x61, 0x9f, 0xdd, 0x7a, 0x1f, 0x6e, 0x4c, 0x6d, 0x6d, 0x43, 0x2e, 0xbd,
+	0xf3, 0x6a, 0x7e, 0xe7, 0xd6, 0xe9, 0x32, 0x5c, 0xd5, 0x13, 0xf1, 0x47, 0xa8, 0x9a, 0x73, 0xc3,
+	0xf7, 0x0b, 0xcc, 0x66, 0xaf, 0xdd, 0x69, 0x5c, 0x26, 0x33, 0xd0, 0xf5, 0x9d, 0x2f, 0xa7, 0xff,
+	0xbe, 0x2d, 0x6d, 0xe2, 0xdb, 0x34, 0xd3, 0x9f, 0xfd, 0x2e, 0xa9, 0x3d, 0xd0, 0x1f, 0x08, 0xd6,
+	0xa6, 0xbf, 0x6a, 0x4c, 0xe7, 0xf9, 0xcf, 0xb9, 0x3e, 0x67, 0xaf, 0x7c, 0x83, 0x45, 0x7b, 0xa4,
+	0xd1, 0x1a, 0xf8, 0x5e, 0x01, 0xda, 0xcc, 0x79, 0xe1, 0x9f, 0x08, 0x56, 0xf3, 0x56, 0xf8, 0x71,
+	0xb9, 0x91, 0x19, 0x21, 0x29, 0x2b, 0xb7, 0x7c, 0x6d, 0xcd, 0xb7, 0x87, 0x49, 0x19, 0x3e, 0xfa,
+	0xc9, 0x3e, 0x7e, 0xee, 0xbc, 0x38, 0x1e, 0xb9, 0xe8, 0x64, 0xe4, 0xa2, 0xbf, 0x23, 0x17, 0x7d,
+	0x1d, 0xbb, 0x95, 0x93, 0xb1, 0x5b, 0xf9, 0x3d, 0x76, 0x2b, 0x6f, 0x9a, 0x22, 0x48, 0x0e, 0x53,
+	0x8f, 0xf4, 0xe4, 0x80, 0x1e, 0xa4, 0xf1, 0x30, 0xe4, 0x89, 0x7e, 0x3d, 0x4c, 0x3d, 0x3a, 0x90,
+	0x7e, 0xda, 0xe7, 0xea, 0x7c, 0x44, 0x32, 0x8c, 0xb8, 0xf2, 0xaa, 0xfa, 0x2f, 0xef, 0xc9, 0xff,
+	0x01, 0x00, 0xc2, 0x69, 0xc4, 0x67, 0x96, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +380,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Supers returns all Supers
 	Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error)
+	// BlockedAddresses returns all blocked addresses
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+	// BlockedAddress returns the blocklist entry of the given address
+	BlockedAddress(ctx context.Context, in *QueryBlockedAddressRequest, opts ...grpc.CallOption) (*QueryBlockedAddressResponse, error)
 }
 
 type queryClient struct {
@@ -196,10 +403,32 @@ func (c *queryClient) Supers(ctx context.Context, in *QuerySupersRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.guardian.Query/BlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddress(ctx context.Context, in *QueryBlockedAddressRequest, opts ...grpc.CallOption) (*QueryBlockedAddressResponse, error) {
+	out := new(QueryBlockedAddressResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.guardian.Query/BlockedAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Supers returns all Supers
 	Supers(context.Context, *QuerySupersRequest) (*QuerySupersResponse, error)
+	// BlockedAddresses returns all blocked addresses
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+	// BlockedAddress returns the blocklist entry of the given address
+	BlockedAddress(context.Context, *QueryBlockedAddressRequest) (*QueryBlockedAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -209,6 +438,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Supers(ctx context.Context, req *QuerySupersRequest) (*QuerySupersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Supers not implemented")
 }
+func (*UnimplementedQueryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAddress(ctx context.Context, req *QueryBlockedAddressRequest) (*QueryBlockedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -232,6 +467,42 @@ func _Query_Supers_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.guardian.Query/BlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.guardian.Query/BlockedAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddress(ctx, req.(*QueryBlockedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.guardian.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -240,6 +511,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Supers",
 			Handler:    _Query_Supers_Handler,
 		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+		{
+			MethodName: "BlockedAddress",
+			Handler:    _Query_BlockedAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "guardian/query.proto",
@@ -329,56 +608,259 @@ func (m *QuerySupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBlockedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySupersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBlockedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Supers) > 0 {
-		for _, e := range m.Supers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBlockedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBlockedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryBlockedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockedAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySupersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for _, e := range m.Supers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for _, e := range m.BlockedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockedAddress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -584,6 +1066,377 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlockedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, BlockedAddress{})
+			if err := m.BlockedAddresses[len(m.BlockedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockedAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -215,36 +215,244 @@ func (m *MsgDeleteSuperResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteSuperResponse proto.InternalMessageInfo
 
+// MsgBlockAddress defines the properties of block address message, the
+// operator is either a super or the gov module account
+type MsgBlockAddress struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgBlockAddress) Reset()         { *m = MsgBlockAddress{} }
+func (m *MsgBlockAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockAddress) ProtoMessage()    {}
+func (*MsgBlockAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{4}
+}
+func (m *MsgBlockAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlockAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockAddress.Merge(m, src)
+}
+func (m *MsgBlockAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockAddress proto.InternalMessageInfo
+
+func (m *MsgBlockAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgBlockAddress) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgBlockAddress) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgBlockAddressResponse defines the Msg/BlockAddress response type
+type MsgBlockAddressResponse struct {
+}
+
+func (m *MsgBlockAddressResponse) Reset()         { *m = MsgBlockAddressResponse{} }
+func (m *MsgBlockAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockAddressResponse) ProtoMessage()    {}
+func (*MsgBlockAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{5}
+}
+func (m *MsgBlockAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlockAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockAddressResponse.Merge(m, src)
+}
+func (m *MsgBlockAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockAddressResponse proto.InternalMessageInfo
+
+// MsgUnblockAddress defines the properties of unblock address message, the
+// operator is either a super or the gov module account
+type MsgUnblockAddress struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgUnblockAddress) Reset()         { *m = MsgUnblockAddress{} }
+func (m *MsgUnblockAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockAddress) ProtoMessage()    {}
+func (*MsgUnblockAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{6}
+}
+func (m *MsgUnblockAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnblockAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockAddress.Merge(m, src)
+}
+func (m *MsgUnblockAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockAddress proto.InternalMessageInfo
+
+func (m *MsgUnblockAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUnblockAddress) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgUnblockAddress) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgUnblockAddressResponse defines the Msg/UnblockAddress response type
+type MsgUnblockAddressResponse struct {
+}
+
+func (m *MsgUnblockAddressResponse) Reset()         { *m = MsgUnblockAddressResponse{} }
+func (m *MsgUnblockAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockAddressResponse) ProtoMessage()    {}
+func (*MsgUnblockAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{7}
+}
+func (m *MsgUnblockAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnblockAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockAddressResponse.Merge(m, src)
+}
+func (m *MsgUnblockAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockAddressResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddSuper)(nil), "gridiron.guardian.MsgAddSuper")
 	proto.RegisterType((*MsgAddSuperResponse)(nil), "gridiron.guardian.MsgAddSuperResponse")
 	proto.RegisterType((*MsgDeleteSuper)(nil), "gridiron.guardian.MsgDeleteSuper")
 	proto.RegisterType((*MsgDeleteSuperResponse)(nil), "gridiron.guardian.MsgDeleteSuperResponse")
+	proto.RegisterType((*MsgBlockAddress)(nil), "gridiron.guardian.MsgBlockAddress")
+	proto.RegisterType((*MsgBlockAddressResponse)(nil), "gridiron.guardian.MsgBlockAddressResponse")
+	proto.RegisterType((*MsgUnblockAddress)(nil), "gridiron.guardian.MsgUnblockAddress")
+	proto.RegisterType((*MsgUnblockAddressResponse)(nil), "gridiron.guardian.MsgUnblockAddressResponse")
 }
 
 func init() { proto.RegisterFile("guardian/tx.proto", fileDescriptor_b62288115d705ce8) }
 
 var fileDescriptor_b62288115d705ce8 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbf, 0x4a, 0x03, 0x41,
-	0x10, 0xc6, 0xb3, 0x06, 0x34, 0x99, 0x80, 0x90, 0x15, 0xe5, 0x0c, 0xb8, 0xc4, 0x2b, 0x44, 0x0b,
-	0xef, 0x40, 0x1b, 0x5b, 0x83, 0x8d, 0x45, 0x9a, 0xb3, 0xd3, 0x42, 0x2e, 0x99, 0x61, 0x5d, 0x48,
-	0x6e, 0x8f, 0xdd, 0x3b, 0xf0, 0x7c, 0x0a, 0x9f, 0xc6, 0x67, 0xb0, 0x4c, 0x69, 0x29, 0xc9, 0x8b,
-	0x08, 0xa7, 0x7b, 0xae, 0xf8, 0xaf, 0x9c, 0xf9, 0xbe, 0xfd, 0x7d, 0xb3, 0xc3, 0x40, 0x5f, 0x96,
-	0xa9, 0x41, 0x95, 0x66, 0x71, 0x71, 0x1f, 0xe5, 0x46, 0x17, 0x9a, 0xf7, 0xa5, 0x51, 0xa8, 0x8c,
-	0xce, 0x22, 0xa7, 0x85, 0x08, 0xbd, 0xb1, 0x95, 0xe7, 0x88, 0x57, 0x65, 0x4e, 0x86, 0x0f, 0xa1,
-	0x87, 0x64, 0xa7, 0x46, 0xe5, 0x85, 0xd2, 0x59, 0xc0, 0x86, 0xec, 0xb0, 0x9b, 0xf8, 0x2d, 0x1e,
-	0xc0, 0x46, 0x8a, 0x68, 0xc8, 0xda, 0x60, 0xad, 0x56, 0x5d, 0xc9, 0x77, 0xa1, 0x93, 0x22, 0x12,
-	0xde, 0x4e, 0xaa, 0xa0, 0xdd, 0x48, 0x84, 0xa3, 0x2a, 0xdc, 0x86, 0x2d, 0x2f, 0x25, 0x21, 0x9b,
-	0xeb, 0xcc, 0x52, 0x78, 0x09, 0x9b, 0x63, 0x2b, 0x2f, 0x68, 0x46, 0x05, 0xbd, 0xe7, 0xff, 0x4e,
-	0xdf, 0x03, 0xc0, 0xda, 0xe8, 0xf1, 0xbb, 0x1f, 0x9d, 0x51, 0x15, 0x06, 0xb0, 0xf3, 0x15, 0xe5,
-	0x42, 0x4e, 0x9e, 0x18, 0xb4, 0xc7, 0x56, 0xf2, 0x04, 0x3a, 0xcd, 0x37, 0x45, 0xf4, 0x6d, 0x13,
-	0x91, 0x37, 0xe0, 0xe0, 0xe0, 0x6f, 0xdd, 0xb1, 0xf9, 0x0d, 0xf4, 0xfc, 0xe9, 0xf7, 0x7f, 0x7e,
-	0xe6, 0x59, 0x06, 0x47, 0xff, 0x5a, 0x1c, 0x7c, 0x94, 0x3c, 0x2f, 0x05, 0x5b, 0x2c, 0x05, 0x7b,
-	0x5d, 0x0a, 0xf6, 0xb8, 0x12, 0xad, 0xc5, 0x4a, 0xb4, 0x5e, 0x56, 0xa2, 0x75, 0x7d, 0x26, 0x55,
-	0x71, 0x57, 0x4e, 0xa2, 0xa9, 0x9e, 0xc7, 0x0e, 0x77, 0xfc, 0xa0, 0x33, 0x6a, 0xaa, 0x78, 0xae,
-	0xb1, 0x9c, 0x91, 0x8d, 0x3f, 0x8f, 0xa0, 0xca, 0xc9, 0x4e, 0xd6, 0xeb, 0x43, 0x38, 0x7d, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0xe9, 0xcb, 0xeb, 0x05, 0x1d, 0x02, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x9b, 0x16, 0xfa, 0xb7, 0xb7, 0x3f, 0x95, 0x46, 0xac, 0x69, 0xc4, 0x50, 0x83, 0x88,
+	0x8a, 0x24, 0xa8, 0x4f, 0xd0, 0xe0, 0x46, 0x24, 0x9b, 0x8a, 0x1b, 0x05, 0x4b, 0xd2, 0x19, 0xa7,
+	0xc1, 0x36, 0x13, 0x66, 0x12, 0x30, 0x6f, 0xe1, 0x63, 0xb9, 0xec, 0xd2, 0xa5, 0xb4, 0xaf, 0xe1,
+	0x42, 0x88, 0x49, 0x9c, 0xb4, 0xd5, 0xba, 0x71, 0x15, 0xee, 0x9c, 0x73, 0xcf, 0xb7, 0x38, 0xb9,
+	0xd0, 0x22, 0x91, 0xc3, 0x90, 0xe7, 0xf8, 0x66, 0xf8, 0x64, 0x04, 0x8c, 0x86, 0x54, 0x6e, 0x11,
+	0xe6, 0x21, 0x8f, 0x51, 0xdf, 0xc8, 0x34, 0x1d, 0x41, 0xc3, 0xe6, 0xa4, 0x87, 0xd0, 0x75, 0x14,
+	0x60, 0x26, 0x77, 0xa1, 0x81, 0x30, 0x1f, 0x32, 0x2f, 0x08, 0x3d, 0xea, 0x2b, 0x52, 0x57, 0x3a,
+	0xac, 0xf7, 0xc5, 0x27, 0x59, 0x81, 0x7f, 0x0e, 0x42, 0x0c, 0x73, 0xae, 0x94, 0x13, 0x35, 0x1b,
+	0xe5, 0x0e, 0xd4, 0x1c, 0x84, 0x30, 0x1a, 0xb8, 0xb1, 0x52, 0xc9, 0x25, 0x8c, 0xac, 0x58, 0xdf,
+	0x82, 0x4d, 0x81, 0xd2, 0xc7, 0x3c, 0xa0, 0x3e, 0xc7, 0xfa, 0x25, 0x34, 0x6d, 0x4e, 0x2e, 0xf0,
+	0x18, 0x87, 0xf8, 0x93, 0xff, 0x7d, 0xfa, 0x2e, 0x00, 0x4a, 0x8c, 0x42, 0x7e, 0x3d, 0x7d, 0xb1,
+	0x62, 0x5d, 0x81, 0x76, 0x31, 0x2a, 0x87, 0x0c, 0x60, 0xc3, 0xe6, 0xc4, 0x1a, 0xd3, 0xe1, 0x63,
+	0x2f, 0xcd, 0x12, 0x28, 0x52, 0x91, 0xd2, 0x86, 0x2a, 0xc3, 0x0e, 0xa7, 0x7e, 0x8a, 0x4f, 0x27,
+	0x59, 0x85, 0x1a, 0x0d, 0x30, 0x73, 0x42, 0xca, 0x52, 0x76, 0x3e, 0xeb, 0x1d, 0xd8, 0x5e, 0x00,
+	0xe4, 0x6c, 0x07, 0x5a, 0x36, 0x27, 0x37, 0xbe, 0xfb, 0x77, 0xf4, 0x1d, 0xe8, 0x2c, 0x21, 0x32,
+	0xfe, 0xd9, 0x7b, 0x19, 0x2a, 0x36, 0x27, 0x72, 0x1f, 0x6a, 0x79, 0xc5, 0x9a, 0xb1, 0xf4, 0x17,
+	0x18, 0x42, 0x39, 0xea, 0xc1, 0xcf, 0x7a, 0x96, 0x2d, 0xdf, 0x41, 0x43, 0x6c, 0x6e, 0x6f, 0xf5,
+	0x9a, 0x60, 0x51, 0x8f, 0xd6, 0x5a, 0xf2, 0xf0, 0x7b, 0xf8, 0x5f, 0x68, 0x4c, 0x5f, 0xbd, 0x2a,
+	0x7a, 0xd4, 0xe3, 0xf5, 0x9e, 0x3c, 0x1f, 0x41, 0x73, 0xa1, 0x95, 0xfd, 0xd5, 0xdb, 0x45, 0x97,
+	0x7a, 0xf2, 0x1b, 0x57, 0x46, 0xb1, 0xae, 0x5e, 0x66, 0x9a, 0x34, 0x9d, 0x69, 0xd2, 0xdb, 0x4c,
+	0x93, 0x9e, 0xe7, 0x5a, 0x69, 0x3a, 0xd7, 0x4a, 0xaf, 0x73, 0xad, 0x74, 0x7b, 0x4a, 0xbc, 0x70,
+	0x14, 0xb9, 0xc6, 0x90, 0x4e, 0xcc, 0x87, 0x88, 0xc5, 0x3e, 0x0e, 0x93, 0xef, 0x28, 0x72, 0xcd,
+	0x09, 0x45, 0xd1, 0x18, 0x73, 0xf3, 0xeb, 0x7e, 0xe3, 0x00, 0x73, 0xb7, 0x9a, 0xdc, 0xf0, 0xf9,
+	0xc7, 0x00, 0x35, 0x2c, 0x58, 0x48, 0xd8, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +471,10 @@ type MsgClient interface {
 	AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error)
 	// DeleteSuper defines a method for deleting a super account
 	DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error)
+	// BlockAddress defines a method for adding an address to the blocklist
+	BlockAddress(ctx context.Context, in *MsgBlockAddress, opts ...grpc.CallOption) (*MsgBlockAddressResponse, error)
+	// UnblockAddress defines a method for removing an address from the blocklist
+	UnblockAddress(ctx context.Context, in *MsgUnblockAddress, opts ...grpc.CallOption) (*MsgUnblockAddressResponse, error)
 }
 
 type msgClient struct {
@@ -291,12 +503,34 @@ func (c *msgClient) DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ..
 	return out, nil
 }
 
+func (c *msgClient) BlockAddress(ctx context.Context, in *MsgBlockAddress, opts ...grpc.CallOption) (*MsgBlockAddressResponse, error) {
+	out := new(MsgBlockAddressResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.guardian.Msg/BlockAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockAddress(ctx context.Context, in *MsgUnblockAddress, opts ...grpc.CallOption) (*MsgUnblockAddressResponse, error) {
+	out := new(MsgUnblockAddressResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.guardian.Msg/UnblockAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddSuper defines a method for adding a super account
 	AddSuper(context.Context, *MsgAddSuper) (*MsgAddSuperResponse, error)
 	// DeleteSuper defines a method for deleting a super account
 	DeleteSuper(context.Context, *MsgDeleteSuper) (*MsgDeleteSuperResponse, error)
+	// BlockAddress defines a method for adding an address to the blocklist
+	BlockAddress(context.Context, *MsgBlockAddress) (*MsgBlockAddressResponse, error)
+	// UnblockAddress defines a method for removing an address from the blocklist
+	UnblockAddress(context.Context, *MsgUnblockAddress) (*MsgUnblockAddressResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -309,6 +543,12 @@ func (*UnimplementedMsgServer) AddSuper(ctx context.Context, req *MsgAddSuper) (
 func (*UnimplementedMsgServer) DeleteSuper(ctx context.Context, req *MsgDeleteSuper) (*MsgDeleteSuperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSuper not implemented")
 }
+func (*UnimplementedMsgServer) BlockAddress(ctx context.Context, req *MsgBlockAddress) (*MsgBlockAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAddress not implemented")
+}
+func (*UnimplementedMsgServer) UnblockAddress(ctx context.Context, req *MsgUnblockAddress) (*MsgUnblockAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAddress not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -350,6 +590,42 @@ func _Msg_DeleteSuper_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BlockAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.guardian.Msg/BlockAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockAddress(ctx, req.(*MsgBlockAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.guardian.Msg/UnblockAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockAddress(ctx, req.(*MsgUnblockAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.guardian.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -362,6 +638,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSuper",
 			Handler:    _Msg_DeleteSuper_Handler,
 		},
+		{
+			MethodName: "BlockAddress",
+			Handler:    _Msg_BlockAddress_Handler,
+		},
+		{
+			MethodName: "UnblockAddress",
+			Handler:    _Msg_UnblockAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "guardian/tx.proto",
@@ -494,80 +778,634 @@ func (m *MsgDeleteSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgBlockAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddSuper) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgBlockAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlockAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBlockAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBlockAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnblockAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnblockAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddSuperResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteSuper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DeletedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteSuperResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
+func (m *MsgBlockAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,15 +1428,15 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSuper: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlockAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlockAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -626,11 +1464,11 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -658,11 +1496,11 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -690,7 +1528,7 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -713,7 +1551,7 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBlockAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -736,10 +1574,10 @@ func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSuperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlockAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlockAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -763,7 +1601,7 @@ func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,13 +1624,13 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSuper: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -824,9 +1662,41 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -854,7 +1724,7 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -877,7 +1747,7 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -900,10 +1770,10 @@ func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSuperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18,6 +18,16 @@ func NewSuper(description string, accountType AccountType, address, addedBy sdk.
 	}
 }
 
+// NewBlockedAddress constructs a blocklist entry
+func NewBlockedAddress(address sdk.AccAddress, reason string, blockedBy sdk.AccAddress, height int64) BlockedAddress {
+	return BlockedAddress{
+		Address:   address.String(),
+		Reason:    reason,
+		BlockedBy: blockedBy.String(),
+		Height:    height,
+	}
+}
+
 // Equal returns if the guardian is equal to specified guardian
 func (g Super) Equal(super Super) bool {
 	return g.Address == super.Address &&
@@ -0,0 +1,41 @@
+// nolint
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagDeputy         = "deputy"
+	FlagFixedFee       = "fixed-fee"
+	FlagActive         = "active"
+	FlagSupplyLimit    = "supply-limit"
+	FlagTimeLimited    = "time-limited"
+	FlagTimePeriod     = "time-period"
+	FlagTimeBasedLimit = "time-based-limit"
+	FlagMinSwapAmount  = "min-swap-amount"
+	FlagMaxSwapAmount  = "max-swap-amount"
+	FlagMinBlockLock   = "min-block-lock"
+	FlagMaxBlockLock   = "max-block-lock"
+)
+
+// common flagsets to add to various functions
+var (
+	FsAddAsset    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAssetLimits = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsAddAsset.String(FlagDeputy, "", "bech32 address of the deputy relaying the swaps of the asset")
+	FsAddAsset.String(FlagFixedFee, "0", "fixed fee charged by the deputy for each swap")
+	FsAddAsset.Bool(FlagActive, true, "whether the asset accepts new swaps")
+
+	FsAssetLimits.String(FlagSupplyLimit, "", "absolute supply limit of the asset")
+	FsAssetLimits.Bool(FlagTimeLimited, false, "whether the supply is also limited by time")
+	FsAssetLimits.Duration(FlagTimePeriod, 0, "period the time based limit applies to, e.g. 24h")
+	FsAssetLimits.String(FlagTimeBasedLimit, "", "supply limit of the asset for each time period")
+	FsAssetLimits.String(FlagMinSwapAmount, "", "minimum amount of a swap")
+	FsAssetLimits.String(FlagMaxSwapAmount, "", "maximum amount of a swap")
+	FsAssetLimits.Uint64(FlagMinBlockLock, 0, "minimum block lock of a swap")
+	FsAssetLimits.Uint64(FlagMaxBlockLock, 0, "maximum block lock of a swap")
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	"github.com/furynet/furyhub/modules/htlcasset/types"
+)
+
+// GetQueryCmd returns the cli query commands for the htlcasset module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the htlcasset module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQuerySupplyUsage(),
+	)
+	return queryCmd
+}
+
+// GetCmdQuerySupplyUsage implements the query supply usage command.
+func GetCmdQuerySupplyUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-usage [denom]",
+		Short: "Query the supply of the HTLC assets against their limits",
+		Example: fmt.Sprintf(
+			"%s query htlcasset supply-usage\n%s query htlcasset supply-usage htltbcbnb",
+			version.AppName, version.AppName,
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := htlctypes.NewQueryClient(clientCtx)
+
+			params, err := queryClient.Params(context.Background(), &htlctypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			supplies, err := queryClient.AssetSupplies(context.Background(), &htlctypes.QueryAssetSuppliesRequest{})
+			if err != nil {
+				return err
+			}
+			supplyOf := make(map[string]htlctypes.AssetSupply, len(supplies.AssetSupplies))
+			for _, supply := range supplies.AssetSupplies {
+				supplyOf[supply.CurrentSupply.Denom] = supply
+			}
+
+			res := &types.AssetUsages{}
+			for _, asset := range params.Params.AssetParams {
+				if len(args) > 0 && asset.Denom != args[0] {
+					continue
+				}
+				res.Usages = append(res.Usages, types.NewAssetUsage(asset, supplyOf[asset.Denom]))
+			}
+			if len(args) > 0 && len(res.Usages) == 0 {
+				return fmt.Errorf("asset %s not found", args[0])
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,248 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	"github.com/furynet/furyhub/modules/htlcasset/types"
+)
+
+// NewTxCmd returns the transaction commands for the htlcasset module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "HTLC asset transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdAddAsset(),
+		GetCmdSetAssetDeputy(),
+		GetCmdSetAssetActive("pause", false),
+		GetCmdSetAssetActive("resume", true),
+		GetCmdSetAssetLimits(),
+	)
+	return txCmd
+}
+
+// GetCmdAddAsset implements the add asset command.
+func GetCmdAddAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-asset [denom]",
+		Short: "Add an asset to the HTLC params",
+		Example: fmt.Sprintf(
+			"%s tx htlcasset add-asset htltbcbnb --deputy=<address> --fixed-fee=1000 --supply-limit=350000000000000 --min-swap-amount=1 --max-swap-amount=1000000000000 --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deputy, _ := cmd.Flags().GetString(FlagDeputy)
+			fixedFeeStr, _ := cmd.Flags().GetString(FlagFixedFee)
+			fixedFee, ok := sdk.NewIntFromString(fixedFeeStr)
+			if !ok {
+				return fmt.Errorf("invalid fixed fee: %s", fixedFeeStr)
+			}
+			active, _ := cmd.Flags().GetBool(FlagActive)
+
+			asset := htlctypes.AssetParam{
+				Denom:         args[0],
+				DeputyAddress: deputy,
+				FixedFee:      fixedFee,
+				Active:        active,
+			}
+			limits, err := readAssetLimits(cmd.Flags(), types.AssetLimits{
+				SupplyLimit:    sdk.ZeroInt(),
+				TimeBasedLimit: sdk.ZeroInt(),
+				MinSwapAmount:  sdk.ZeroInt(),
+				MaxSwapAmount:  sdk.ZeroInt(),
+				MinBlockLock:   htlctypes.MinTimeLock,
+				MaxBlockLock:   htlctypes.MaxTimeLock,
+			})
+			if err != nil {
+				return err
+			}
+			limits.Apply(&asset)
+
+			msg := types.NewMsgAddAsset(asset, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAddAsset)
+	cmd.Flags().AddFlagSet(FsAssetLimits)
+	_ = cmd.MarkFlagRequired(FlagDeputy)
+	_ = cmd.MarkFlagRequired(FlagSupplyLimit)
+	_ = cmd.MarkFlagRequired(FlagMinSwapAmount)
+	_ = cmd.MarkFlagRequired(FlagMaxSwapAmount)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAssetDeputy implements the set asset deputy command.
+func GetCmdSetAssetDeputy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-deputy [denom] [deputy-address]",
+		Short: "Rotate the deputy of an HTLC asset",
+		Example: fmt.Sprintf(
+			"%s tx htlcasset set-deputy htltbcbnb <address> --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deputy, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAssetDeputy(args[0], deputy, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAssetActive implements the pause and resume asset commands.
+func GetCmdSetAssetActive(use string, active bool) *cobra.Command {
+	short := "Stop an HTLC asset from accepting new swaps"
+	if active {
+		short = "Let a paused HTLC asset accept new swaps again"
+	}
+	cmd := &cobra.Command{
+		Use:   use + " [denom]",
+		Short: short,
+		Example: fmt.Sprintf(
+			"%s tx htlcasset %s htltbcbnb --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName, use,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAssetActive(args[0], active, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAssetLimits implements the set asset limits command.
+func GetCmdSetAssetLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-limits [denom]",
+		Short: "Change the limits of an HTLC asset",
+		Long: `Change the limits of an HTLC asset. The limits whose flag is not given keep
+the current value of the asset, queried from the node.`,
+		Example: fmt.Sprintf(
+			"%s tx htlcasset set-limits htltbcbnb --supply-limit=500000000000000 --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := htlctypes.NewQueryClient(clientCtx).Params(context.Background(), &htlctypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			var current *htlctypes.AssetParam
+			for i, asset := range res.Params.AssetParams {
+				if asset.Denom == args[0] {
+					current = &res.Params.AssetParams[i]
+					break
+				}
+			}
+			if current == nil {
+				return fmt.Errorf("asset %s not found", args[0])
+			}
+
+			limits, err := readAssetLimits(cmd.Flags(), types.NewAssetLimits(*current))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAssetLimits(args[0], limits, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetLimits)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readAssetLimits overrides the given limits with the limit flags that are set
+func readAssetLimits(fs *flag.FlagSet, limits types.AssetLimits) (types.AssetLimits, error) {
+	for name, amount := range map[string]*sdk.Int{
+		FlagSupplyLimit:    &limits.SupplyLimit,
+		FlagTimeBasedLimit: &limits.TimeBasedLimit,
+		FlagMinSwapAmount:  &limits.MinSwapAmount,
+		FlagMaxSwapAmount:  &limits.MaxSwapAmount,
+	} {
+		if !fs.Changed(name) {
+			continue
+		}
+		str, _ := fs.GetString(name)
+		value, ok := sdk.NewIntFromString(str)
+		if !ok {
+			return limits, fmt.Errorf("invalid %s: %s", name, str)
+		}
+		*amount = value
+	}
+	if fs.Changed(FlagTimeLimited) {
+		limits.TimeLimited, _ = fs.GetBool(FlagTimeLimited)
+	}
+	if fs.Changed(FlagTimePeriod) {
+		limits.TimePeriod, _ = fs.GetDuration(FlagTimePeriod)
+	}
+	if fs.Changed(FlagMinBlockLock) {
+		limits.MinBlockLock, _ = fs.GetUint64(FlagMinBlockLock)
+	}
+	if fs.Changed(FlagMaxBlockLock) {
+		limits.MaxBlockLock, _ = fs.GetUint64(FlagMaxBlockLock)
+	}
+	return limits, nil
+}
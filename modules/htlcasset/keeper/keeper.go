@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/htlcasset/types"
+)
+
+// Keeper manages the HTLT assets in the params of the htlc module, it has no
+// store of its own
+type Keeper struct {
+	htlcKeeper     types.HTLCKeeper
+	guardianKeeper types.GuardianKeeper
+
+	// the address capable of managing the assets besides the genesis
+	// supers, usually the gov module account
+	authority string
+}
+
+// NewKeeper returns a htlcasset keeper
+func NewKeeper(
+	htlcKeeper types.HTLCKeeper,
+	guardianKeeper types.GuardianKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		htlcKeeper:     htlcKeeper,
+		guardianKeeper: guardianKeeper,
+		authority:      authority,
+	}
+}
+
+// GetAuthority returns the address allowed to manage the assets besides the genesis supers
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CanManageAssets returns true if the operator is the authority or a genesis
+// super: the deputy of an asset controls the minting of its supply, which the
+// ordinary supers are not trusted with
+func (k Keeper) CanManageAssets(ctx sdk.Context, operator sdk.AccAddress) bool {
+	if operator.String() == k.authority {
+		return true
+	}
+	super, found := k.guardianKeeper.GetSuper(ctx, operator)
+	return found && super.AccountType == guardiantypes.Genesis
+}
+
+// AddAsset adds the asset to the htlc params, along with an empty supply
+func (k Keeper) AddAsset(ctx sdk.Context, asset htlctypes.AssetParam) error {
+	params := k.htlcKeeper.GetParams(ctx)
+	if _, found := findAsset(params, asset.Denom); found {
+		return sdkerrors.Wrap(types.ErrAssetExists, asset.Denom)
+	}
+	params.AssetParams = append(params.AssetParams, asset)
+	if err := k.setParams(ctx, params); err != nil {
+		return err
+	}
+
+	// the supplies of the assets removed from the params are kept
+	if _, found := k.htlcKeeper.GetAssetSupply(ctx, asset.Denom); !found {
+		zero := sdk.NewCoin(asset.Denom, sdk.ZeroInt())
+		k.htlcKeeper.SetAssetSupply(ctx, htlctypes.NewAssetSupply(zero, zero, zero, zero, 0), asset.Denom)
+	}
+	return nil
+}
+
+// SetAssetDeputy sets the deputy of the asset
+func (k Keeper) SetAssetDeputy(ctx sdk.Context, denom string, deputy sdk.AccAddress) error {
+	return k.updateAsset(ctx, denom, func(asset *htlctypes.AssetParam) error {
+		asset.DeputyAddress = deputy.String()
+		return nil
+	})
+}
+
+// SetAssetActive pauses or resumes the swaps of the asset
+func (k Keeper) SetAssetActive(ctx sdk.Context, denom string, active bool) error {
+	return k.updateAsset(ctx, denom, func(asset *htlctypes.AssetParam) error {
+		asset.Active = active
+		return nil
+	})
+}
+
+// SetAssetLimits sets the limits of the asset, the supply limit cannot be
+// lower than the supply in use
+func (k Keeper) SetAssetLimits(ctx sdk.Context, denom string, limits types.AssetLimits) error {
+	return k.updateAsset(ctx, denom, func(asset *htlctypes.AssetParam) error {
+		if supply, found := k.htlcKeeper.GetAssetSupply(ctx, denom); found {
+			used := supply.CurrentSupply.Amount.Add(supply.IncomingSupply.Amount)
+			if used.GT(limits.SupplyLimit) {
+				return sdkerrors.Wrapf(types.ErrLimitBelowUsage, "limit %s, supply in use %s", limits.SupplyLimit, used)
+			}
+		}
+		limits.Apply(asset)
+		return nil
+	})
+}
+
+// updateAsset applies the update to the params of the given asset
+func (k Keeper) updateAsset(ctx sdk.Context, denom string, update func(asset *htlctypes.AssetParam) error) error {
+	params := k.htlcKeeper.GetParams(ctx)
+	i, found := findAsset(params, denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrAssetNotFound, denom)
+	}
+	if err := update(&params.AssetParams[i]); err != nil {
+		return err
+	}
+	return k.setParams(ctx, params)
+}
+
+// setParams validates and sets the htlc params
+func (k Keeper) setParams(ctx sdk.Context, params htlctypes.Params) error {
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidAsset, err.Error())
+	}
+	k.htlcKeeper.SetParams(ctx, params)
+	return nil
+}
+
+// findAsset returns the index of the asset in the params
+func findAsset(params htlctypes.Params, denom string) (int, bool) {
+	for i, asset := range params.AssetParams {
+		if asset.Denom == denom {
+			return i, true
+		}
+	}
+	return -1, false
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/htlcasset/keeper"
+	"github.com/furynet/furyhub/modules/htlcasset/types"
+	"github.com/furynet/furyhub/simapp"
+)
+
+const denom = "htltbcbnb"
+
+var (
+	super    = sdk.AccAddress([]byte("test-super-address"))
+	ordinary = sdk.AccAddress([]byte("test-ordinary-super"))
+	stranger = sdk.AccAddress([]byte("test-stranger-addr"))
+	deputy   = sdk.AccAddress([]byte("test-deputy-address"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	app    *simapp.SimApp
+	keeper keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+	suite.keeper = app.HTLCAssetKeeper
+
+	app.GuardianKeeper.AddSuper(suite.ctx, guardiantypes.NewSuper("test", guardiantypes.Genesis, super, super))
+	app.GuardianKeeper.AddSuper(suite.ctx, guardiantypes.NewSuper("test", guardiantypes.Ordinary, ordinary, super))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func testAsset() htlctypes.AssetParam {
+	return htlctypes.NewAssetParam(
+		denom, 714,
+		htlctypes.SupplyLimit{
+			Limit:          sdk.NewInt(1000),
+			TimeLimited:    true,
+			TimePeriod:     24 * time.Hour,
+			TimeBasedLimit: sdk.NewInt(100),
+		},
+		true, deputy.String(), sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(100),
+		htlctypes.MinTimeLock, htlctypes.MaxTimeLock,
+	)
+}
+
+func (suite *KeeperTestSuite) getAsset() htlctypes.AssetParam {
+	for _, asset := range suite.app.HTLCKeeper.GetParams(suite.ctx).AssetParams {
+		if asset.Denom == denom {
+			return asset
+		}
+	}
+	suite.FailNow("asset not found")
+	return htlctypes.AssetParam{}
+}
+
+func (suite *KeeperTestSuite) TestAddAsset() {
+	suite.NoError(suite.keeper.AddAsset(suite.ctx, testAsset()))
+	suite.Equal(testAsset(), suite.getAsset())
+
+	supply, found := suite.app.HTLCKeeper.GetAssetSupply(suite.ctx, denom)
+	suite.True(found)
+	suite.True(supply.CurrentSupply.IsZero())
+	suite.Equal(denom, supply.CurrentSupply.Denom)
+
+	suite.ErrorIs(suite.keeper.AddAsset(suite.ctx, testAsset()), types.ErrAssetExists)
+
+	invalid := testAsset()
+	invalid.Denom = "htltbusd"
+	invalid.MinSwapAmount = sdk.ZeroInt()
+	suite.ErrorIs(suite.keeper.AddAsset(suite.ctx, invalid), types.ErrInvalidAsset)
+	suite.Len(suite.app.HTLCKeeper.GetParams(suite.ctx).AssetParams, 1)
+}
+
+func (suite *KeeperTestSuite) TestUpdateAsset() {
+	newDeputy := sdk.AccAddress([]byte("test-new-deputy-addr"))
+	suite.ErrorIs(suite.keeper.SetAssetDeputy(suite.ctx, denom, newDeputy), types.ErrAssetNotFound)
+
+	suite.NoError(suite.keeper.AddAsset(suite.ctx, testAsset()))
+
+	suite.NoError(suite.keeper.SetAssetDeputy(suite.ctx, denom, newDeputy))
+	suite.Equal(newDeputy.String(), suite.getAsset().DeputyAddress)
+
+	suite.NoError(suite.keeper.SetAssetActive(suite.ctx, denom, false))
+	suite.False(suite.getAsset().Active)
+
+	limits := types.NewAssetLimits(testAsset())
+	limits.SupplyLimit = sdk.NewInt(5000)
+	limits.MaxSwapAmount = sdk.NewInt(500)
+	suite.NoError(suite.keeper.SetAssetLimits(suite.ctx, denom, limits))
+	suite.Equal(limits, types.NewAssetLimits(suite.getAsset()))
+	suite.Equal(newDeputy.String(), suite.getAsset().DeputyAddress)
+}
+
+func (suite *KeeperTestSuite) TestSetAssetLimitsBelowUsage() {
+	suite.NoError(suite.keeper.AddAsset(suite.ctx, testAsset()))
+
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
+	suite.app.HTLCKeeper.SetAssetSupply(suite.ctx, htlctypes.NewAssetSupply(coin(200), coin(0), coin(600), coin(0), 0), denom)
+
+	limits := types.NewAssetLimits(testAsset())
+	limits.SupplyLimit = sdk.NewInt(799)
+	suite.ErrorIs(suite.keeper.SetAssetLimits(suite.ctx, denom, limits), types.ErrLimitBelowUsage)
+	suite.Equal(testAsset(), suite.getAsset())
+
+	limits.SupplyLimit = sdk.NewInt(800)
+	suite.NoError(suite.keeper.SetAssetLimits(suite.ctx, denom, limits))
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.AddAsset(ctx, types.NewMsgAddAsset(testAsset(), stranger))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+
+	_, err = msgServer.AddAsset(ctx, types.NewMsgAddAsset(testAsset(), super))
+	suite.NoError(err)
+
+	_, err = msgServer.SetAssetActive(ctx, types.NewMsgSetAssetActive(denom, false, stranger))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+	suite.True(suite.getAsset().Active)
+
+	// the ordinary supers can't rotate the deputy nor change the limits
+	newDeputy := sdk.AccAddress([]byte("test-new-deputy-addr"))
+	_, err = msgServer.SetAssetDeputy(ctx, types.NewMsgSetAssetDeputy(denom, newDeputy, ordinary))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+	suite.Equal(deputy.String(), suite.getAsset().DeputyAddress)
+	limits := types.NewAssetLimits(testAsset())
+	limits.SupplyLimit = sdk.NewInt(5000)
+	_, err = msgServer.SetAssetLimits(ctx, types.NewMsgSetAssetLimits(denom, limits, ordinary))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+	suite.Equal(testAsset(), suite.getAsset())
+
+	_, err = msgServer.SetAssetDeputy(ctx, types.NewMsgSetAssetDeputy(denom, newDeputy, super))
+	suite.NoError(err)
+	suite.Equal(newDeputy.String(), suite.getAsset().DeputyAddress)
+
+	// the gov module account pauses the asset through a proposal
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.SetAssetActive(ctx, types.NewMsgSetAssetActive(denom, false, gov))
+	suite.NoError(err)
+	suite.False(suite.getAsset().Active)
+
+	var found bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		found = found || event.Type == types.EventTypeSetAssetActive
+	}
+	suite.True(found)
+}
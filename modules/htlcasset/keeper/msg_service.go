@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furynet/furyhub/modules/htlcasset/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the htlcasset MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) AddAsset(goCtx context.Context, msg *types.MsgAddAsset) (*types.MsgAddAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.authorize(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.AddAsset(ctx, msg.Asset()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeAddAsset,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyDeputy, msg.DeputyAddress),
+			sdk.NewAttribute(types.AttributeKeyActive, strconv.FormatBool(msg.Active)),
+			sdk.NewAttribute(types.AttributeKeySupplyLimit, msg.Limits.SupplyLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgAddAssetResponse{}, nil
+}
+
+func (m msgServer) SetAssetDeputy(goCtx context.Context, msg *types.MsgSetAssetDeputy) (*types.MsgSetAssetDeputyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.authorize(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+	deputy, err := sdk.AccAddressFromBech32(msg.DeputyAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.SetAssetDeputy(ctx, msg.Denom, deputy); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetAssetDeputy,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyDeputy, msg.DeputyAddress),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetAssetDeputyResponse{}, nil
+}
+
+func (m msgServer) SetAssetActive(goCtx context.Context, msg *types.MsgSetAssetActive) (*types.MsgSetAssetActiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.authorize(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.SetAssetActive(ctx, msg.Denom, msg.Active); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetAssetActive,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyActive, strconv.FormatBool(msg.Active)),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetAssetActiveResponse{}, nil
+}
+
+func (m msgServer) SetAssetLimits(goCtx context.Context, msg *types.MsgSetAssetLimits) (*types.MsgSetAssetLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.authorize(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.SetAssetLimits(ctx, msg.Denom, msg.Limits); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetAssetLimits,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeySupplyLimit, msg.Limits.SupplyLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetAssetLimitsResponse{}, nil
+}
+
+// authorize checks the operator can manage the assets
+func (m msgServer) authorize(ctx sdk.Context, operator string) error {
+	addr, err := sdk.AccAddressFromBech32(operator)
+	if err != nil {
+		return err
+	}
+	if !m.Keeper.CanManageAssets(ctx, addr) {
+		return sdkerrors.Wrap(types.ErrUnknownOperator, operator)
+	}
+	return nil
+}
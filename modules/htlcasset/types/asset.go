@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+)
+
+// NewAssetLimits returns the limits of the given asset params
+func NewAssetLimits(asset htlctypes.AssetParam) AssetLimits {
+	return AssetLimits{
+		SupplyLimit:    asset.SupplyLimit.Limit,
+		TimeLimited:    asset.SupplyLimit.TimeLimited,
+		TimePeriod:     asset.SupplyLimit.TimePeriod,
+		TimeBasedLimit: asset.SupplyLimit.TimeBasedLimit,
+		MinSwapAmount:  asset.MinSwapAmount,
+		MaxSwapAmount:  asset.MaxSwapAmount,
+		MinBlockLock:   asset.MinBlockLock,
+		MaxBlockLock:   asset.MaxBlockLock,
+	}
+}
+
+// Apply sets the limits on the given asset params
+func (l AssetLimits) Apply(asset *htlctypes.AssetParam) {
+	asset.SupplyLimit = htlctypes.SupplyLimit{
+		Limit:          l.SupplyLimit,
+		TimeLimited:    l.TimeLimited,
+		TimePeriod:     l.TimePeriod,
+		TimeBasedLimit: l.TimeBasedLimit,
+	}
+	asset.MinSwapAmount = l.MinSwapAmount
+	asset.MaxSwapAmount = l.MaxSwapAmount
+	asset.MinBlockLock = l.MinBlockLock
+	asset.MaxBlockLock = l.MaxBlockLock
+}
+
+// ValidateAsset validates the asset params against the params of the htlc module
+func ValidateAsset(asset htlctypes.AssetParam) error {
+	for _, amount := range []sdk.Int{
+		asset.SupplyLimit.Limit,
+		asset.SupplyLimit.TimeBasedLimit,
+		asset.FixedFee,
+		asset.MinSwapAmount,
+		asset.MaxSwapAmount,
+	} {
+		if amount.IsNil() {
+			return sdkerrors.Wrapf(ErrInvalidAsset, "asset %s has an empty amount", asset.Denom)
+		}
+	}
+	if err := htlctypes.NewParams([]htlctypes.AssetParam{asset}).Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAsset, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary module/htlcasset interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddAsset{}, "gridiron/htlcasset/MsgAddAsset", nil)
+	cdc.RegisterConcrete(&MsgSetAssetDeputy{}, "gridiron/htlcasset/MsgSetAssetDeputy", nil)
+	cdc.RegisterConcrete(&MsgSetAssetActive{}, "gridiron/htlcasset/MsgSetAssetActive", nil)
+	cdc.RegisterConcrete(&MsgSetAssetLimits{}, "gridiron/htlcasset/MsgSetAssetLimits", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddAsset{},
+		&MsgSetAssetDeputy{},
+		&MsgSetAssetActive{},
+		&MsgSetAssetLimits{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
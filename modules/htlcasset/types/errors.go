@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// htlcasset module sentinel errors
+var (
+	ErrUnknownOperator = sdkerrors.Register(ModuleName, 2, "unknown operator")
+	ErrInvalidAsset    = sdkerrors.Register(ModuleName, 3, "invalid asset")
+	ErrAssetExists     = sdkerrors.Register(ModuleName, 4, "asset already exists")
+	ErrAssetNotFound   = sdkerrors.Register(ModuleName, 5, "asset not found")
+	ErrLimitBelowUsage = sdkerrors.Register(ModuleName, 6, "supply limit below the supply in use")
+)
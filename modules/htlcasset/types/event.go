@@ -0,0 +1,18 @@
+// nolint
+package types
+
+// htlcasset module event types
+const (
+	EventTypeAddAsset       = "add_htlc_asset"
+	EventTypeSetAssetDeputy = "set_htlc_asset_deputy"
+	EventTypeSetAssetActive = "set_htlc_asset_active"
+	EventTypeSetAssetLimits = "set_htlc_asset_limits"
+
+	AttributeKeyDenom       = "denom"
+	AttributeKeyDeputy      = "deputy_address"
+	AttributeKeyActive      = "active"
+	AttributeKeySupplyLimit = "supply_limit"
+	AttributeKeyOperator    = "operator"
+
+	AttributeValueCategory = ModuleName
+)
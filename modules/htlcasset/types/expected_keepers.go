@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+)
+
+// GuardianKeeper defines the expected guardian keeper
+type GuardianKeeper interface {
+	GetSuper(ctx sdk.Context, addr sdk.AccAddress) (guardiantypes.Super, bool)
+}
+
+// HTLCKeeper defines the expected htlc keeper
+type HTLCKeeper interface {
+	GetParams(ctx sdk.Context) htlctypes.Params
+	SetParams(ctx sdk.Context, params htlctypes.Params)
+	GetAssetSupply(ctx sdk.Context, denom string) (htlctypes.AssetSupply, bool)
+	SetAssetSupply(ctx sdk.Context, supply htlctypes.AssetSupply, denom string)
+}
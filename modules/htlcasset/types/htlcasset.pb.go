@@ -0,0 +1,1736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: htlcasset/htlcasset.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AssetLimits defines the limits of an HTLT asset, as in the asset params of
+// the htlc module
+type AssetLimits struct {
+	// supply_limit is the absolute supply limit of the asset
+	SupplyLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=supply_limit,json=supplyLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply_limit" yaml:"supply_limit"`
+	// time_limited tells whether the supply is also limited by time
+	TimeLimited bool `protobuf:"varint,2,opt,name=time_limited,json=timeLimited,proto3" json:"time_limited,omitempty" yaml:"time_limited"`
+	// time_period is the duration the time based limit applies to
+	TimePeriod time.Duration `protobuf:"bytes,3,opt,name=time_period,json=timePeriod,proto3,stdduration" json:"time_period" yaml:"time_period"`
+	// time_based_limit is the supply limit of the asset for each time period
+	TimeBasedLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=time_based_limit,json=timeBasedLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"time_based_limit" yaml:"time_based_limit"`
+	// min_swap_amount is the minimum amount of a swap
+	MinSwapAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_swap_amount,json=minSwapAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_swap_amount" yaml:"min_swap_amount"`
+	// max_swap_amount is the maximum amount of a swap
+	MaxSwapAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_swap_amount" yaml:"max_swap_amount"`
+	// min_block_lock is the minimum block lock of a swap
+	MinBlockLock uint64 `protobuf:"varint,7,opt,name=min_block_lock,json=minBlockLock,proto3" json:"min_block_lock,omitempty" yaml:"min_block_lock"`
+	// max_block_lock is the maximum block lock of a swap
+	MaxBlockLock uint64 `protobuf:"varint,8,opt,name=max_block_lock,json=maxBlockLock,proto3" json:"max_block_lock,omitempty" yaml:"max_block_lock"`
+}
+
+func (m *AssetLimits) Reset()         { *m = AssetLimits{} }
+func (m *AssetLimits) String() string { return proto.CompactTextString(m) }
+func (*AssetLimits) ProtoMessage()    {}
+func (*AssetLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6687bc1e5f06a14e, []int{0}
+}
+func (m *AssetLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetLimits.Merge(m, src)
+}
+func (m *AssetLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetLimits proto.InternalMessageInfo
+
+func (m *AssetLimits) GetTimeLimited() bool {
+	if m != nil {
+		return m.TimeLimited
+	}
+	return false
+}
+
+func (m *AssetLimits) GetTimePeriod() time.Duration {
+	if m != nil {
+		return m.TimePeriod
+	}
+	return 0
+}
+
+func (m *AssetLimits) GetMinBlockLock() uint64 {
+	if m != nil {
+		return m.MinBlockLock
+	}
+	return 0
+}
+
+func (m *AssetLimits) GetMaxBlockLock() uint64 {
+	if m != nil {
+		return m.MaxBlockLock
+	}
+	return 0
+}
+
+// AssetUsage defines the supply of an HTLT asset against its limits
+type AssetUsage struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Active        bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	DeputyAddress string `protobuf:"bytes,3,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	// supply_limit is the absolute supply limit of the asset
+	SupplyLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=supply_limit,json=supplyLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply_limit" yaml:"supply_limit"`
+	// current_supply is the supply of the asset on the chain
+	CurrentSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=current_supply,json=currentSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_supply" yaml:"current_supply"`
+	// incoming_supply is the supply locked by the incoming swaps
+	IncomingSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=incoming_supply,json=incomingSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"incoming_supply" yaml:"incoming_supply"`
+	// outgoing_supply is the supply locked by the outgoing swaps
+	OutgoingSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=outgoing_supply,json=outgoingSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outgoing_supply" yaml:"outgoing_supply"`
+	// available is what remains of the supply limit for new incoming swaps
+	Available github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=available,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"available"`
+	// usage is the share of the supply limit taken by the current and
+	// incoming supplies
+	Usage       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=usage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"usage"`
+	TimeLimited bool                                   `protobuf:"varint,10,opt,name=time_limited,json=timeLimited,proto3" json:"time_limited,omitempty" yaml:"time_limited"`
+	// time_based_limit is the supply limit of the asset for each time period
+	TimeBasedLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=time_based_limit,json=timeBasedLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"time_based_limit" yaml:"time_based_limit"`
+	// time_limited_current_supply is the supply added in the current period
+	TimeLimitedCurrentSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=time_limited_current_supply,json=timeLimitedCurrentSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"time_limited_current_supply" yaml:"time_limited_current_supply"`
+	// time_limited_usage is the share of the time based limit taken in the
+	// current period
+	TimeLimitedUsage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=time_limited_usage,json=timeLimitedUsage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"time_limited_usage" yaml:"time_limited_usage"`
+	TimeElapsed      time.Duration                          `protobuf:"bytes,14,opt,name=time_elapsed,json=timeElapsed,proto3,stdduration" json:"time_elapsed" yaml:"time_elapsed"`
+	TimePeriod       time.Duration                          `protobuf:"bytes,15,opt,name=time_period,json=timePeriod,proto3,stdduration" json:"time_period" yaml:"time_period"`
+}
+
+func (m *AssetUsage) Reset()         { *m = AssetUsage{} }
+func (m *AssetUsage) String() string { return proto.CompactTextString(m) }
+func (*AssetUsage) ProtoMessage()    {}
+func (*AssetUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6687bc1e5f06a14e, []int{1}
+}
+func (m *AssetUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetUsage.Merge(m, src)
+}
+func (m *AssetUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetUsage proto.InternalMessageInfo
+
+func (m *AssetUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetUsage) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *AssetUsage) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
+	}
+	return ""
+}
+
+func (m *AssetUsage) GetTimeLimited() bool {
+	if m != nil {
+		return m.TimeLimited
+	}
+	return false
+}
+
+func (m *AssetUsage) GetTimeElapsed() time.Duration {
+	if m != nil {
+		return m.TimeElapsed
+	}
+	return 0
+}
+
+func (m *AssetUsage) GetTimePeriod() time.Duration {
+	if m != nil {
+		return m.TimePeriod
+	}
+	return 0
+}
+
+// AssetUsages defines the supply usage of the HTLT assets
+type AssetUsages struct {
+	Usages []AssetUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
+}
+
+func (m *AssetUsages) Reset()         { *m = AssetUsages{} }
+func (m *AssetUsages) String() string { return proto.CompactTextString(m) }
+func (*AssetUsages) ProtoMessage()    {}
+func (*AssetUsages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6687bc1e5f06a14e, []int{2}
+}
+func (m *AssetUsages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetUsages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetUsages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetUsages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetUsages.Merge(m, src)
+}
+func (m *AssetUsages) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetUsages) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetUsages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetUsages proto.InternalMessageInfo
+
+func (m *AssetUsages) GetUsages() []AssetUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AssetLimits)(nil), "gridiron.htlcasset.AssetLimits")
+	proto.RegisterType((*AssetUsage)(nil), "gridiron.htlcasset.AssetUsage")
+	proto.RegisterType((*AssetUsages)(nil), "gridiron.htlcasset.AssetUsages")
+}
+
+func init() { proto.RegisterFile("htlcasset/htlcasset.proto", fileDescriptor_6687bc1e5f06a14e) }
+
+var fileDescriptor_6687bc1e5f06a14e = []byte{
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0x4b, 0x12, 0xc8, 0xe4, 0x0f, 0xc8, 0xa5, 0xe0, 0x50, 0xc9, 0x8e, 0xe6, 0x50, 0xe5,
+	0x52, 0x47, 0xa2, 0x37, 0x54, 0xa9, 0xc5, 0x05, 0xb5, 0x88, 0x1c, 0xaa, 0xa1, 0xbd, 0x20, 0x55,
+	0xd6, 0xc4, 0x1e, 0x1c, 0x0b, 0xdb, 0xe3, 0x7a, 0x6c, 0x48, 0xbe, 0x45, 0xa5, 0x5e, 0x7a, 0xec,
+	0xc7, 0xe1, 0x52, 0x89, 0x63, 0xb5, 0x87, 0xec, 0x0a, 0x2e, 0x7b, 0x5c, 0xe5, 0x13, 0xac, 0x3c,
+	0xe3, 0x10, 0xc7, 0x41, 0x5a, 0x59, 0x2c, 0x97, 0xd8, 0xef, 0xbd, 0xbc, 0xdf, 0xef, 0xbd, 0x79,
+	0x3f, 0xcf, 0x0c, 0xe8, 0x8e, 0x63, 0xcf, 0xc2, 0x8c, 0x91, 0x78, 0xf0, 0xf4, 0xa6, 0x87, 0x11,
+	0x8d, 0xa9, 0x2c, 0x3b, 0x91, 0x6b, 0xbb, 0x11, 0x0d, 0xf4, 0xa7, 0xc8, 0xc1, 0xae, 0x43, 0x1d,
+	0xca, 0xc3, 0x83, 0xf4, 0x4d, 0xfc, 0xf3, 0x40, 0x75, 0x28, 0x75, 0x3c, 0x32, 0xe0, 0xd6, 0x28,
+	0xb9, 0x1a, 0xd8, 0x49, 0x84, 0x63, 0x97, 0x06, 0x22, 0x0e, 0x3f, 0xd4, 0x40, 0xf3, 0x38, 0xcd,
+	0x1f, 0xba, 0xbe, 0x1b, 0x33, 0x79, 0x0c, 0x5a, 0x2c, 0x09, 0x43, 0x6f, 0x6a, 0x7a, 0xa9, 0x43,
+	0x91, 0x7a, 0x52, 0xbf, 0x61, 0x9c, 0xde, 0xcd, 0xb4, 0xca, 0x9b, 0x99, 0xf6, 0x8d, 0xe3, 0xc6,
+	0xe3, 0x64, 0xa4, 0x5b, 0xd4, 0x1f, 0x58, 0x94, 0xf9, 0x94, 0x65, 0x8f, 0x6f, 0x99, 0x7d, 0x3d,
+	0x88, 0xa7, 0x21, 0x61, 0xfa, 0x59, 0x10, 0xcf, 0x67, 0xda, 0x97, 0x53, 0xec, 0x7b, 0x47, 0x30,
+	0x8f, 0x05, 0x51, 0x53, 0x98, 0x9c, 0x4a, 0x3e, 0x02, 0xad, 0xd8, 0xf5, 0x89, 0x88, 0x11, 0x5b,
+	0xf9, 0xa2, 0x27, 0xf5, 0xb7, 0x8c, 0xfd, 0x65, 0x6e, 0x3e, 0x0a, 0x51, 0x33, 0x35, 0x87, 0xc2,
+	0x92, 0x2f, 0x01, 0x37, 0xcd, 0x90, 0x44, 0x2e, 0xb5, 0x95, 0x8d, 0x9e, 0xd4, 0x6f, 0x1e, 0x76,
+	0x75, 0xd1, 0xab, 0xbe, 0xe8, 0x55, 0x3f, 0xc9, 0x7a, 0x35, 0xd4, 0xb4, 0xfe, 0xf9, 0x4c, 0x93,
+	0x73, 0xc8, 0x22, 0x17, 0xfe, 0xf3, 0x56, 0x93, 0x10, 0x48, 0x3d, 0xbf, 0x72, 0x87, 0xcc, 0xc0,
+	0x0e, 0x8f, 0x8f, 0x30, 0x23, 0x76, 0xb6, 0x0a, 0x55, 0xbe, 0x0a, 0x67, 0xa5, 0x57, 0x61, 0x3f,
+	0xc7, 0x97, 0xc3, 0x83, 0xa8, 0x93, 0xba, 0x8c, 0xd4, 0x23, 0x16, 0x23, 0x04, 0xdb, 0xbe, 0x1b,
+	0x98, 0xec, 0x16, 0x87, 0x26, 0xf6, 0x69, 0x12, 0xc4, 0x4a, 0x8d, 0x73, 0xfe, 0x52, 0x9a, 0x73,
+	0x4f, 0x70, 0x16, 0xe0, 0x20, 0x6a, 0xfb, 0x6e, 0x70, 0x71, 0x8b, 0xc3, 0x63, 0x6e, 0x73, 0x46,
+	0x3c, 0x59, 0x61, 0xac, 0xbf, 0x90, 0x11, 0x4f, 0x8a, 0x8c, 0x78, 0x92, 0x63, 0xfc, 0x01, 0x74,
+	0xd2, 0xa2, 0x46, 0x1e, 0xb5, 0xae, 0xcd, 0xf4, 0x47, 0xd9, 0xec, 0x49, 0xfd, 0xaa, 0xd1, 0x9d,
+	0xcf, 0xb4, 0xaf, 0x96, 0x45, 0x2f, 0xe3, 0x10, 0xb5, 0x7c, 0x37, 0x30, 0xd2, 0xd7, 0x21, 0xb5,
+	0xae, 0x39, 0x00, 0x9e, 0xe4, 0x01, 0xb6, 0xd6, 0x00, 0xf0, 0xa4, 0x00, 0x80, 0x27, 0x4f, 0x00,
+	0x47, 0xd5, 0xf7, 0xff, 0x6a, 0x12, 0xfc, 0x0f, 0x00, 0xc0, 0x25, 0xff, 0x3b, 0xc3, 0x0e, 0x91,
+	0x77, 0x41, 0xcd, 0x26, 0x01, 0xf5, 0x85, 0xd4, 0x91, 0x30, 0xe4, 0x3d, 0x50, 0xc7, 0x56, 0xec,
+	0xde, 0x10, 0xa1, 0x4b, 0x94, 0x59, 0xf2, 0x8f, 0xa0, 0x63, 0x93, 0x30, 0x89, 0xa7, 0x26, 0xb6,
+	0xed, 0x88, 0x30, 0xc6, 0xc5, 0xd7, 0xc8, 0xd7, 0xb0, 0x1a, 0x87, 0xa8, 0x2d, 0x1c, 0xc7, 0xc2,
+	0x5e, 0xfb, 0xc2, 0xaa, 0xaf, 0xf6, 0x85, 0x05, 0xa0, 0x63, 0x25, 0x51, 0x44, 0x82, 0xd8, 0x14,
+	0xee, 0x4c, 0x53, 0x3f, 0x97, 0xe6, 0xca, 0x3a, 0x5b, 0x45, 0x83, 0xa8, 0x9d, 0x39, 0x2e, 0xb8,
+	0x2d, 0xff, 0x09, 0xb6, 0xdd, 0xc0, 0xa2, 0xbe, 0x1b, 0x38, 0x0b, 0xc2, 0x17, 0x4a, 0xaa, 0x00,
+	0x07, 0x51, 0x67, 0xe1, 0x59, 0x52, 0xd2, 0x24, 0x76, 0x68, 0x8e, 0x72, 0xf3, 0x65, 0x94, 0x05,
+	0x38, 0x88, 0x3a, 0x0b, 0x4f, 0x46, 0x39, 0x04, 0x0d, 0x7c, 0x83, 0x5d, 0x0f, 0x8f, 0x3c, 0xc2,
+	0x05, 0xd8, 0x30, 0xf4, 0x72, 0x64, 0x68, 0x09, 0x20, 0x9f, 0x80, 0x5a, 0x92, 0xca, 0x50, 0x69,
+	0x94, 0x46, 0x3a, 0x21, 0x16, 0x12, 0xc9, 0x6b, 0x7b, 0x29, 0x28, 0xb1, 0x97, 0x3e, 0xb7, 0xdf,
+	0x35, 0x5f, 0x7b, 0xbf, 0xfb, 0x5b, 0x02, 0x5f, 0xe7, 0x6b, 0x32, 0x0b, 0x42, 0x6d, 0xf1, 0x02,
+	0x7e, 0x2b, 0x5d, 0x00, 0x5c, 0x6f, 0xd7, 0x2c, 0xaa, 0x56, 0xc9, 0x75, 0xff, 0xd3, 0x8a, 0x80,
+	0xa7, 0x40, 0x5e, 0xc9, 0x14, 0x93, 0x69, 0xf3, 0x5a, 0xce, 0xcb, 0x4d, 0x66, 0x3e, 0xd3, 0xba,
+	0xcf, 0xd4, 0xc2, 0x11, 0x21, 0xda, 0xc9, 0x95, 0x20, 0x76, 0xa1, 0x3f, 0xb2, 0x09, 0x12, 0x0f,
+	0x87, 0x8c, 0xd8, 0x4a, 0xe7, 0x53, 0x47, 0x9a, 0x96, 0x1d, 0x69, 0xf9, 0x01, 0x67, 0xc9, 0xe2,
+	0x4c, 0xe3, 0x43, 0x3e, 0x15, 0x9e, 0xe2, 0x81, 0xb9, 0xfd, 0x19, 0x0f, 0x4c, 0x78, 0x9e, 0xdd,
+	0x20, 0x78, 0x23, 0x4c, 0xfe, 0x1e, 0xd4, 0x79, 0x97, 0x4c, 0x91, 0x7a, 0x1b, 0xfd, 0xe6, 0xa1,
+	0xaa, 0xaf, 0x5f, 0x56, 0xf4, 0x65, 0x82, 0x51, 0x4d, 0xa9, 0x50, 0x96, 0x63, 0x0c, 0xef, 0x1e,
+	0x54, 0xe9, 0xfe, 0x41, 0x95, 0xde, 0x3d, 0xa8, 0xd2, 0x5f, 0x8f, 0x6a, 0xe5, 0xfe, 0x51, 0xad,
+	0xfc, 0xff, 0xa8, 0x56, 0x2e, 0x0f, 0x73, 0x0b, 0x7f, 0x95, 0x44, 0xd3, 0x80, 0xc4, 0xfc, 0x39,
+	0x4e, 0x46, 0x03, 0x9f, 0xda, 0x89, 0x47, 0xd8, 0xf2, 0x9e, 0x24, 0x06, 0x31, 0xaa, 0xf3, 0xce,
+	0xbe, 0xfb, 0x38, 0x00, 0x0e, 0xde, 0x4d, 0x4c, 0x4b, 0x09, 0x00, 0x00,
+}
+
+func (this *AssetLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AssetLimits)
+	if !ok {
+		that2, ok := that.(AssetLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SupplyLimit.Equal(that1.SupplyLimit) {
+		return false
+	}
+	if this.TimeLimited != that1.TimeLimited {
+		return false
+	}
+	if this.TimePeriod != that1.TimePeriod {
+		return false
+	}
+	if !this.TimeBasedLimit.Equal(that1.TimeBasedLimit) {
+		return false
+	}
+	if !this.MinSwapAmount.Equal(that1.MinSwapAmount) {
+		return false
+	}
+	if !this.MaxSwapAmount.Equal(that1.MaxSwapAmount) {
+		return false
+	}
+	if this.MinBlockLock != that1.MinBlockLock {
+		return false
+	}
+	if this.MaxBlockLock != that1.MaxBlockLock {
+		return false
+	}
+	return true
+}
+func (m *AssetLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockLock != 0 {
+		i = encodeVarintHtlcasset(dAtA, i, uint64(m.MaxBlockLock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinBlockLock != 0 {
+		i = encodeVarintHtlcasset(dAtA, i, uint64(m.MinBlockLock))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxSwapAmount.Size()
+		i -= size
+		if _, err := m.MaxSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinSwapAmount.Size()
+		i -= size
+		if _, err := m.MinSwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TimeBasedLimit.Size()
+		i -= size
+		if _, err := m.TimeBasedLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHtlcasset(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.TimeLimited {
+		i--
+		if m.TimeLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.SupplyLimit.Size()
+		i -= size
+		if _, err := m.SupplyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimePeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHtlcasset(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x7a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeElapsed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeElapsed):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintHtlcasset(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.TimeLimitedUsage.Size()
+		i -= size
+		if _, err := m.TimeLimitedUsage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TimeLimitedCurrentSupply.Size()
+		i -= size
+		if _, err := m.TimeLimitedCurrentSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TimeBasedLimit.Size()
+		i -= size
+		if _, err := m.TimeBasedLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.TimeLimited {
+		i--
+		if m.TimeLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Usage.Size()
+		i -= size
+		if _, err := m.Usage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Available.Size()
+		i -= size
+		if _, err := m.Available.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.OutgoingSupply.Size()
+		i -= size
+		if _, err := m.OutgoingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.IncomingSupply.Size()
+		i -= size
+		if _, err := m.IncomingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentSupply.Size()
+		i -= size
+		if _, err := m.CurrentSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SupplyLimit.Size()
+		i -= size
+		if _, err := m.SupplyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintHtlcasset(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHtlcasset(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetUsages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetUsages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetUsages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHtlcasset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHtlcasset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHtlcasset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AssetLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplyLimit.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	if m.TimeLimited {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimePeriod)
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.TimeBasedLimit.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.MinSwapAmount.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.MaxSwapAmount.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	if m.MinBlockLock != 0 {
+		n += 1 + sovHtlcasset(uint64(m.MinBlockLock))
+	}
+	if m.MaxBlockLock != 0 {
+		n += 1 + sovHtlcasset(uint64(m.MaxBlockLock))
+	}
+	return n
+}
+
+func (m *AssetUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHtlcasset(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovHtlcasset(uint64(l))
+	}
+	l = m.SupplyLimit.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.IncomingSupply.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.OutgoingSupply.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.Available.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	if m.TimeLimited {
+		n += 2
+	}
+	l = m.TimeBasedLimit.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.TimeLimitedCurrentSupply.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = m.TimeLimitedUsage.Size()
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeElapsed)
+	n += 1 + l + sovHtlcasset(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimePeriod)
+	n += 1 + l + sovHtlcasset(uint64(l))
+	return n
+}
+
+func (m *AssetUsages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovHtlcasset(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovHtlcasset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHtlcasset(x uint64) (n int) {
+	return sovHtlcasset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AssetLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHtlcasset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLimited = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeBasedLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockLock", wireType)
+			}
+			m.MinBlockLock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockLock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockLock", wireType)
+			}
+			m.MaxBlockLock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockLock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHtlcasset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHtlcasset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncomingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutgoingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Available.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLimited = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeBasedLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitedCurrentSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeLimitedCurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitedUsage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeLimitedUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeElapsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeElapsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHtlcasset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetUsages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHtlcasset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetUsages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetUsages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, AssetUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHtlcasset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHtlcasset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHtlcasset(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHtlcasset
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHtlcasset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHtlcasset
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHtlcasset
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHtlcasset
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHtlcasset        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHtlcasset          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHtlcasset = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,10 @@
+package types
+
+// nolint
+const (
+	// module name
+	ModuleName = "htlcasset"
+
+	// RouterKey is the message route for htlcasset
+	RouterKey = ModuleName
+)
@@ -0,0 +1,224 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+)
+
+const (
+	TypeMsgAddAsset       = "add_asset"        // type for MsgAddAsset
+	TypeMsgSetAssetDeputy = "set_asset_deputy" // type for MsgSetAssetDeputy
+	TypeMsgSetAssetActive = "set_asset_active" // type for MsgSetAssetActive
+	TypeMsgSetAssetLimits = "set_asset_limits" // type for MsgSetAssetLimits
+)
+
+var (
+	_ sdk.Msg = &MsgAddAsset{}
+	_ sdk.Msg = &MsgSetAssetDeputy{}
+	_ sdk.Msg = &MsgSetAssetActive{}
+	_ sdk.Msg = &MsgSetAssetLimits{}
+)
+
+// NewMsgAddAsset constructs a MsgAddAsset
+func NewMsgAddAsset(asset htlctypes.AssetParam, operator sdk.AccAddress) *MsgAddAsset {
+	return &MsgAddAsset{
+		Denom:         asset.Denom,
+		DeputyAddress: asset.DeputyAddress,
+		FixedFee:      asset.FixedFee,
+		Limits:        NewAssetLimits(asset),
+		Active:        asset.Active,
+		Operator:      operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgAddAsset) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgAddAsset) Type() string { return TypeMsgAddAsset }
+
+// GetSignBytes implements Msg.
+func (msg MsgAddAsset) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgAddAsset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return ValidateAsset(msg.Asset())
+}
+
+// GetSigners implements Msg.
+func (msg MsgAddAsset) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// Asset returns the asset params carried by the message
+func (msg MsgAddAsset) Asset() htlctypes.AssetParam {
+	asset := htlctypes.AssetParam{
+		Denom:         msg.Denom,
+		Active:        msg.Active,
+		DeputyAddress: msg.DeputyAddress,
+		FixedFee:      msg.FixedFee,
+	}
+	msg.Limits.Apply(&asset)
+	return asset
+}
+
+// ______________________________________________________________________
+
+// NewMsgSetAssetDeputy constructs a MsgSetAssetDeputy
+func NewMsgSetAssetDeputy(denom string, deputy, operator sdk.AccAddress) *MsgSetAssetDeputy {
+	return &MsgSetAssetDeputy{
+		Denom:         denom,
+		DeputyAddress: deputy.String(),
+		Operator:      operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetAssetDeputy) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetAssetDeputy) Type() string { return TypeMsgSetAssetDeputy }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetAssetDeputy) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetAssetDeputy) ValidateBasic() error {
+	if err := validateAssetMsg(msg.Denom, msg.Operator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DeputyAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid deputy address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetAssetDeputy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgSetAssetActive constructs a MsgSetAssetActive
+func NewMsgSetAssetActive(denom string, active bool, operator sdk.AccAddress) *MsgSetAssetActive {
+	return &MsgSetAssetActive{
+		Denom:    denom,
+		Active:   active,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetAssetActive) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetAssetActive) Type() string { return TypeMsgSetAssetActive }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetAssetActive) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetAssetActive) ValidateBasic() error {
+	return validateAssetMsg(msg.Denom, msg.Operator)
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetAssetActive) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgSetAssetLimits constructs a MsgSetAssetLimits
+func NewMsgSetAssetLimits(denom string, limits AssetLimits, operator sdk.AccAddress) *MsgSetAssetLimits {
+	return &MsgSetAssetLimits{
+		Denom:    denom,
+		Limits:   limits,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetAssetLimits) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetAssetLimits) Type() string { return TypeMsgSetAssetLimits }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetAssetLimits) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetAssetLimits) ValidateBasic() error {
+	if err := validateAssetMsg(msg.Denom, msg.Operator); err != nil {
+		return err
+	}
+	// the limits are checked on an asset that is valid otherwise
+	asset := htlctypes.AssetParam{
+		Denom:         msg.Denom,
+		DeputyAddress: msg.Operator,
+		FixedFee:      sdk.ZeroInt(),
+	}
+	msg.Limits.Apply(&asset)
+	return ValidateAsset(asset)
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetAssetLimits) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func validateAssetMsg(denom, operator string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+)
+
+var (
+	operator = sdk.AccAddress([]byte("test-operator-addr"))
+	deputy   = sdk.AccAddress([]byte("test-deputy-address"))
+)
+
+func testAsset() htlctypes.AssetParam {
+	return htlctypes.NewAssetParam(
+		"htltbcbnb", 714,
+		htlctypes.SupplyLimit{
+			Limit:          sdk.NewInt(1000),
+			TimeLimited:    true,
+			TimePeriod:     24 * time.Hour,
+			TimeBasedLimit: sdk.NewInt(100),
+		},
+		true, deputy.String(), sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(100),
+		htlctypes.MinTimeLock, htlctypes.MaxTimeLock,
+	)
+}
+
+func TestMsgAddAssetValidateBasic(t *testing.T) {
+	msg := NewMsgAddAsset(testAsset(), operator)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, testAsset(), msg.Asset())
+
+	tests := []struct {
+		name   string
+		modify func(asset *htlctypes.AssetParam)
+	}{
+		{"no htlt prefix", func(asset *htlctypes.AssetParam) { asset.Denom = "bcbnb" }},
+		{"upper case denom", func(asset *htlctypes.AssetParam) { asset.Denom = "htltBNB" }},
+		{"invalid deputy", func(asset *htlctypes.AssetParam) { asset.DeputyAddress = "deputy" }},
+		{"negative fee", func(asset *htlctypes.AssetParam) { asset.FixedFee = sdk.NewInt(-1) }},
+		{"empty fee", func(asset *htlctypes.AssetParam) { asset.FixedFee = sdk.Int{} }},
+		{"time limit above limit", func(asset *htlctypes.AssetParam) { asset.SupplyLimit.TimeBasedLimit = sdk.NewInt(1001) }},
+		{"zero min swap", func(asset *htlctypes.AssetParam) { asset.MinSwapAmount = sdk.ZeroInt() }},
+		{"min swap above max swap", func(asset *htlctypes.AssetParam) { asset.MinSwapAmount = sdk.NewInt(101) }},
+		{"short block lock", func(asset *htlctypes.AssetParam) { asset.MinBlockLock = htlctypes.MinTimeLock - 1 }},
+		{"long block lock", func(asset *htlctypes.AssetParam) { asset.MaxBlockLock = htlctypes.MaxTimeLock + 1 }},
+	}
+	for _, tc := range tests {
+		asset := testAsset()
+		tc.modify(&asset)
+		require.ErrorIs(t, NewMsgAddAsset(asset, operator).ValidateBasic(), ErrInvalidAsset, tc.name)
+	}
+
+	msg.Operator = ""
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSetAssetLimitsValidateBasic(t *testing.T) {
+	limits := NewAssetLimits(testAsset())
+	require.NoError(t, NewMsgSetAssetLimits("htltbcbnb", limits, operator).ValidateBasic())
+	require.Error(t, NewMsgSetAssetLimits("htltbcbnb", limits, sdk.AccAddress{}).ValidateBasic())
+
+	invalid := limits
+	invalid.MaxBlockLock = invalid.MinBlockLock - 1
+	require.ErrorIs(t, NewMsgSetAssetLimits("htltbcbnb", invalid, operator).ValidateBasic(), ErrInvalidAsset)
+	require.ErrorIs(t, NewMsgSetAssetLimits("htltbcbnb", AssetLimits{}, operator).ValidateBasic(), ErrInvalidAsset)
+}
+
+func TestMsgSetAssetValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgSetAssetDeputy("htltbcbnb", deputy, operator).ValidateBasic())
+	require.Error(t, NewMsgSetAssetDeputy("htltbcbnb", sdk.AccAddress{}, operator).ValidateBasic())
+	require.Error(t, NewMsgSetAssetDeputy("h", deputy, operator).ValidateBasic())
+	require.NoError(t, NewMsgSetAssetActive("htltbcbnb", false, operator).ValidateBasic())
+	require.Error(t, NewMsgSetAssetActive("htltbcbnb", false, sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestMsgAddAssetGetSignBytes(t *testing.T) {
+	msg := NewMsgAddAsset(testAsset(), operator)
+	require.Contains(t, string(msg.GetSignBytes()), `"type":"gridiron/htlcasset/MsgAddAsset"`)
+	require.Contains(t, string(msg.GetSignBytes()), `"supply_limit":"1000"`)
+}
@@ -0,0 +1,2078 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: htlcasset/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddAsset defines the properties of add asset message, the operator is
+// either a genesis super or the gov module account
+type MsgAddAsset struct {
+	Denom         string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	DeputyAddress string                                 `protobuf:"bytes,2,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	FixedFee      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fixed_fee,json=fixedFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fixed_fee" yaml:"fixed_fee"`
+	Limits        AssetLimits                            `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits"`
+	Active        bool                                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	Operator      string                                 `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgAddAsset) Reset()         { *m = MsgAddAsset{} }
+func (m *MsgAddAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAsset) ProtoMessage()    {}
+func (*MsgAddAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{0}
+}
+func (m *MsgAddAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAsset.Merge(m, src)
+}
+func (m *MsgAddAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAsset proto.InternalMessageInfo
+
+func (m *MsgAddAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgAddAsset) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
+	}
+	return ""
+}
+
+func (m *MsgAddAsset) GetLimits() AssetLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return AssetLimits{}
+}
+
+func (m *MsgAddAsset) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *MsgAddAsset) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgAddAssetResponse defines the Msg/AddAsset response type
+type MsgAddAssetResponse struct {
+}
+
+func (m *MsgAddAssetResponse) Reset()         { *m = MsgAddAssetResponse{} }
+func (m *MsgAddAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAssetResponse) ProtoMessage()    {}
+func (*MsgAddAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{1}
+}
+func (m *MsgAddAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAssetResponse.Merge(m, src)
+}
+func (m *MsgAddAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAssetResponse proto.InternalMessageInfo
+
+// MsgSetAssetDeputy defines the properties of set asset deputy message, the
+// operator is either a genesis super or the gov module account
+type MsgSetAssetDeputy struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	DeputyAddress string `protobuf:"bytes,2,opt,name=deputy_address,json=deputyAddress,proto3" json:"deputy_address,omitempty" yaml:"deputy_address"`
+	Operator      string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgSetAssetDeputy) Reset()         { *m = MsgSetAssetDeputy{} }
+func (m *MsgSetAssetDeputy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetDeputy) ProtoMessage()    {}
+func (*MsgSetAssetDeputy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{2}
+}
+func (m *MsgSetAssetDeputy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetDeputy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetDeputy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetDeputy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetDeputy.Merge(m, src)
+}
+func (m *MsgSetAssetDeputy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetDeputy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetDeputy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetDeputy proto.InternalMessageInfo
+
+func (m *MsgSetAssetDeputy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetAssetDeputy) GetDeputyAddress() string {
+	if m != nil {
+		return m.DeputyAddress
+	}
+	return ""
+}
+
+func (m *MsgSetAssetDeputy) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgSetAssetDeputyResponse defines the Msg/SetAssetDeputy response type
+type MsgSetAssetDeputyResponse struct {
+}
+
+func (m *MsgSetAssetDeputyResponse) Reset()         { *m = MsgSetAssetDeputyResponse{} }
+func (m *MsgSetAssetDeputyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetDeputyResponse) ProtoMessage()    {}
+func (*MsgSetAssetDeputyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{3}
+}
+func (m *MsgSetAssetDeputyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetDeputyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetDeputyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetDeputyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetDeputyResponse.Merge(m, src)
+}
+func (m *MsgSetAssetDeputyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetDeputyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetDeputyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetDeputyResponse proto.InternalMessageInfo
+
+// MsgSetAssetActive defines the properties of set asset active message, the
+// operator is either a genesis super or the gov module account
+type MsgSetAssetActive struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Active   bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgSetAssetActive) Reset()         { *m = MsgSetAssetActive{} }
+func (m *MsgSetAssetActive) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetActive) ProtoMessage()    {}
+func (*MsgSetAssetActive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{4}
+}
+func (m *MsgSetAssetActive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetActive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetActive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetActive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetActive.Merge(m, src)
+}
+func (m *MsgSetAssetActive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetActive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetActive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetActive proto.InternalMessageInfo
+
+func (m *MsgSetAssetActive) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetAssetActive) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *MsgSetAssetActive) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgSetAssetActiveResponse defines the Msg/SetAssetActive response type
+type MsgSetAssetActiveResponse struct {
+}
+
+func (m *MsgSetAssetActiveResponse) Reset()         { *m = MsgSetAssetActiveResponse{} }
+func (m *MsgSetAssetActiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetActiveResponse) ProtoMessage()    {}
+func (*MsgSetAssetActiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{5}
+}
+func (m *MsgSetAssetActiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetActiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetActiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetActiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetActiveResponse.Merge(m, src)
+}
+func (m *MsgSetAssetActiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetActiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetActiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetActiveResponse proto.InternalMessageInfo
+
+// MsgSetAssetLimits defines the properties of set asset limits message, the
+// operator is either a genesis super or the gov module account
+type MsgSetAssetLimits struct {
+	Denom    string      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Limits   AssetLimits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits"`
+	Operator string      `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgSetAssetLimits) Reset()         { *m = MsgSetAssetLimits{} }
+func (m *MsgSetAssetLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetLimits) ProtoMessage()    {}
+func (*MsgSetAssetLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{6}
+}
+func (m *MsgSetAssetLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetLimits.Merge(m, src)
+}
+func (m *MsgSetAssetLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetLimits proto.InternalMessageInfo
+
+func (m *MsgSetAssetLimits) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetAssetLimits) GetLimits() AssetLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return AssetLimits{}
+}
+
+func (m *MsgSetAssetLimits) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// MsgSetAssetLimitsResponse defines the Msg/SetAssetLimits response type
+type MsgSetAssetLimitsResponse struct {
+}
+
+func (m *MsgSetAssetLimitsResponse) Reset()         { *m = MsgSetAssetLimitsResponse{} }
+func (m *MsgSetAssetLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetLimitsResponse) ProtoMessage()    {}
+func (*MsgSetAssetLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_308cb43624befa61, []int{7}
+}
+func (m *MsgSetAssetLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetLimitsResponse.Merge(m, src)
+}
+func (m *MsgSetAssetLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetLimitsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddAsset)(nil), "gridiron.htlcasset.MsgAddAsset")
+	proto.RegisterType((*MsgAddAssetResponse)(nil), "gridiron.htlcasset.MsgAddAssetResponse")
+	proto.RegisterType((*MsgSetAssetDeputy)(nil), "gridiron.htlcasset.MsgSetAssetDeputy")
+	proto.RegisterType((*MsgSetAssetDeputyResponse)(nil), "gridiron.htlcasset.MsgSetAssetDeputyResponse")
+	proto.RegisterType((*MsgSetAssetActive)(nil), "gridiron.htlcasset.MsgSetAssetActive")
+	proto.RegisterType((*MsgSetAssetActiveResponse)(nil), "gridiron.htlcasset.MsgSetAssetActiveResponse")
+	proto.RegisterType((*MsgSetAssetLimits)(nil), "gridiron.htlcasset.MsgSetAssetLimits")
+	proto.RegisterType((*MsgSetAssetLimitsResponse)(nil), "gridiron.htlcasset.MsgSetAssetLimitsResponse")
+}
+
+func init() { proto.RegisterFile("htlcasset/tx.proto", fileDescriptor_308cb43624befa61) }
+
+var fileDescriptor_308cb43624befa61 = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0xda, 0xdd, 0xd2, 0x9d, 0xc5, 0x45, 0xc7, 0x5d, 0x49, 0x23, 0x24, 0x25, 0xa0, 0xf6,
+	0xd2, 0x04, 0xea, 0x4d, 0x10, 0x6c, 0x11, 0x41, 0xd8, 0x5e, 0xa2, 0x27, 0x41, 0x4a, 0xda, 0x79,
+	0x4d, 0x83, 0x4d, 0x26, 0x64, 0x26, 0xb2, 0xbd, 0x0b, 0x5e, 0x05, 0x7f, 0x8d, 0xff, 0x60, 0x8f,
+	0x7b, 0x14, 0x0f, 0x45, 0xda, 0x7f, 0xb0, 0xbf, 0x40, 0x32, 0x93, 0xa6, 0xe9, 0xb6, 0xb5, 0x45,
+	0xf0, 0x94, 0x79, 0xf3, 0xbe, 0x37, 0xdf, 0x37, 0xdf, 0x9b, 0x3c, 0x84, 0xc7, 0x7c, 0x32, 0x74,
+	0x19, 0x03, 0x6e, 0xf3, 0x2b, 0x2b, 0x8a, 0x29, 0xa7, 0x18, 0x7b, 0xb1, 0x4f, 0xfc, 0x98, 0x86,
+	0x56, 0x9e, 0xd4, 0xce, 0x3d, 0xea, 0x51, 0x91, 0xb6, 0xd3, 0x95, 0x44, 0x6a, 0xf5, 0x55, 0x75,
+	0xbe, 0x92, 0x29, 0xf3, 0x47, 0x19, 0x9d, 0xf6, 0x98, 0xd7, 0x21, 0xa4, 0x93, 0xee, 0xe2, 0x73,
+	0x74, 0x4c, 0x20, 0xa4, 0x81, 0xaa, 0x34, 0x94, 0xe6, 0x89, 0x23, 0x03, 0xfc, 0x0a, 0x9d, 0x11,
+	0x88, 0x12, 0x3e, 0xed, 0xbb, 0x84, 0xc4, 0xc0, 0x98, 0x5a, 0x4e, 0xd3, 0xdd, 0xfa, 0xed, 0xcc,
+	0xb8, 0x98, 0xba, 0xc1, 0xe4, 0x85, 0xb9, 0x9e, 0x37, 0x9d, 0x7b, 0x72, 0xa3, 0x23, 0x63, 0xdc,
+	0x47, 0x27, 0x23, 0xff, 0x0a, 0x48, 0x7f, 0x04, 0xa0, 0x56, 0x44, 0x71, 0xf7, 0x7a, 0x66, 0x94,
+	0x7e, 0xcd, 0x8c, 0xa7, 0x9e, 0xcf, 0xc7, 0xc9, 0xc0, 0x1a, 0xd2, 0xc0, 0x1e, 0x52, 0x16, 0x50,
+	0x96, 0x7d, 0x5a, 0x8c, 0x7c, 0xb2, 0xf9, 0x34, 0x02, 0x66, 0xbd, 0x0d, 0xf9, 0xed, 0xcc, 0xb8,
+	0x2f, 0xa9, 0xf2, 0x83, 0x4c, 0xa7, 0x26, 0xd6, 0x6f, 0x00, 0xf0, 0x4b, 0x54, 0x9d, 0xf8, 0x81,
+	0xcf, 0x99, 0x7a, 0xd4, 0x50, 0x9a, 0xa7, 0x6d, 0xc3, 0xda, 0xb4, 0xc7, 0x12, 0x77, 0xbc, 0x14,
+	0xb0, 0xee, 0x51, 0x4a, 0xef, 0x64, 0x45, 0xf8, 0x11, 0xaa, 0xba, 0x43, 0xee, 0x7f, 0x06, 0xf5,
+	0xb8, 0xa1, 0x34, 0x6b, 0x4e, 0x16, 0x61, 0x0d, 0xd5, 0x68, 0x04, 0xb1, 0xcb, 0x69, 0xac, 0x56,
+	0x85, 0x25, 0x79, 0x6c, 0x5e, 0xa0, 0x87, 0x05, 0xeb, 0x1c, 0x60, 0x11, 0x0d, 0x19, 0x98, 0x5f,
+	0x15, 0xf4, 0xa0, 0xc7, 0xbc, 0x77, 0xc0, 0xc5, 0xfe, 0x6b, 0xe1, 0xc3, 0x7f, 0x33, 0xb6, 0x28,
+	0xb0, 0x72, 0x47, 0xe0, 0x63, 0x54, 0xdf, 0x10, 0x92, 0xcb, 0xfc, 0xb8, 0xa6, 0xb2, 0x23, 0xaf,
+	0xbb, 0x5d, 0xe5, 0xca, 0x9c, 0xf2, 0x4e, 0x73, 0xfe, 0xce, 0x2d, 0x8f, 0xcf, 0xb9, 0xbf, 0xac,
+	0x5b, 0x24, 0x3b, 0xb2, 0x83, 0x7c, 0xd5, 0xd8, 0xf2, 0xbf, 0x34, 0xf6, 0x70, 0x8d, 0xb2, 0x7c,
+	0xa9, 0xb1, 0xfd, 0xbd, 0x82, 0x2a, 0x3d, 0xe6, 0xe1, 0xf7, 0xa8, 0x96, 0xff, 0x1d, 0x5b, 0xb9,
+	0x0b, 0x6f, 0x40, 0x7b, 0xb6, 0x07, 0xb0, 0x3c, 0x1d, 0x8f, 0xd0, 0xd9, 0x9d, 0x07, 0xf2, 0x64,
+	0x47, 0xe9, 0x3a, 0x4c, 0x6b, 0x1d, 0x04, 0xdb, 0xc6, 0x93, 0xb5, 0x78, 0x1f, 0x8f, 0x84, 0x69,
+	0xad, 0x83, 0x60, 0xdb, 0x78, 0xb2, 0x6e, 0xee, 0xe3, 0x91, 0x30, 0xad, 0x75, 0x10, 0x6c, 0xc9,
+	0xd3, 0xbd, 0xbc, 0x9e, 0xeb, 0xca, 0xcd, 0x5c, 0x57, 0x7e, 0xcf, 0x75, 0xe5, 0xdb, 0x42, 0x2f,
+	0xdd, 0x2c, 0xf4, 0xd2, 0xcf, 0x85, 0x5e, 0xfa, 0xd0, 0x2e, 0x8c, 0x91, 0x51, 0x12, 0x4f, 0x43,
+	0xe0, 0xe2, 0x3b, 0x4e, 0x06, 0x76, 0x40, 0x49, 0x32, 0x01, 0x66, 0x17, 0xa6, 0x68, 0x3a, 0x56,
+	0x06, 0x55, 0x31, 0x04, 0x9f, 0xff, 0x19, 0x00, 0x0f, 0xea, 0x41, 0xce, 0x5f, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddAsset defines a method for adding an HTLT asset
+	AddAsset(ctx context.Context, in *MsgAddAsset, opts ...grpc.CallOption) (*MsgAddAssetResponse, error)
+	// SetAssetDeputy defines a method for rotating the deputy of an HTLT asset
+	SetAssetDeputy(ctx context.Context, in *MsgSetAssetDeputy, opts ...grpc.CallOption) (*MsgSetAssetDeputyResponse, error)
+	// SetAssetActive defines a method for pausing or resuming an HTLT asset
+	SetAssetActive(ctx context.Context, in *MsgSetAssetActive, opts ...grpc.CallOption) (*MsgSetAssetActiveResponse, error)
+	// SetAssetLimits defines a method for changing the limits of an HTLT asset
+	SetAssetLimits(ctx context.Context, in *MsgSetAssetLimits, opts ...grpc.CallOption) (*MsgSetAssetLimitsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddAsset(ctx context.Context, in *MsgAddAsset, opts ...grpc.CallOption) (*MsgAddAssetResponse, error) {
+	out := new(MsgAddAssetResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.htlcasset.Msg/AddAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAssetDeputy(ctx context.Context, in *MsgSetAssetDeputy, opts ...grpc.CallOption) (*MsgSetAssetDeputyResponse, error) {
+	out := new(MsgSetAssetDeputyResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.htlcasset.Msg/SetAssetDeputy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAssetActive(ctx context.Context, in *MsgSetAssetActive, opts ...grpc.CallOption) (*MsgSetAssetActiveResponse, error) {
+	out := new(MsgSetAssetActiveResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.htlcasset.Msg/SetAssetActive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAssetLimits(ctx context.Context, in *MsgSetAssetLimits, opts ...grpc.CallOption) (*MsgSetAssetLimitsResponse, error) {
+	out := new(MsgSetAssetLimitsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.htlcasset.Msg/SetAssetLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddAsset defines a method for adding an HTLT asset
+	AddAsset(context.Context, *MsgAddAsset) (*MsgAddAssetResponse, error)
+	// SetAssetDeputy defines a method for rotating the deputy of an HTLT asset
+	SetAssetDeputy(context.Context, *MsgSetAssetDeputy) (*MsgSetAssetDeputyResponse, error)
+	// SetAssetActive defines a method for pausing or resuming an HTLT asset
+	SetAssetActive(context.Context, *MsgSetAssetActive) (*MsgSetAssetActiveResponse, error)
+	// SetAssetLimits defines a method for changing the limits of an HTLT asset
+	SetAssetLimits(context.Context, *MsgSetAssetLimits) (*MsgSetAssetLimitsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddAsset(ctx context.Context, req *MsgAddAsset) (*MsgAddAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAsset not implemented")
+}
+func (*UnimplementedMsgServer) SetAssetDeputy(ctx context.Context, req *MsgSetAssetDeputy) (*MsgSetAssetDeputyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetDeputy not implemented")
+}
+func (*UnimplementedMsgServer) SetAssetActive(ctx context.Context, req *MsgSetAssetActive) (*MsgSetAssetActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetActive not implemented")
+}
+func (*UnimplementedMsgServer) SetAssetLimits(ctx context.Context, req *MsgSetAssetLimits) (*MsgSetAssetLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetLimits not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.htlcasset.Msg/AddAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAsset(ctx, req.(*MsgAddAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAssetDeputy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetDeputy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetDeputy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.htlcasset.Msg/SetAssetDeputy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetDeputy(ctx, req.(*MsgSetAssetDeputy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAssetActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetActive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.htlcasset.Msg/SetAssetActive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetActive(ctx, req.(*MsgSetAssetActive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAssetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.htlcasset.Msg/SetAssetLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetLimits(ctx, req.(*MsgSetAssetLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gridiron.htlcasset.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAsset",
+			Handler:    _Msg_AddAsset_Handler,
+		},
+		{
+			MethodName: "SetAssetDeputy",
+			Handler:    _Msg_SetAssetDeputy_Handler,
+		},
+		{
+			MethodName: "SetAssetActive",
+			Handler:    _Msg_SetAssetActive_Handler,
+		},
+		{
+			MethodName: "SetAssetLimits",
+			Handler:    _Msg_SetAssetLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "htlcasset/tx.proto",
+}
+
+func (m *MsgAddAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FixedFee.Size()
+		i -= size
+		if _, err := m.FixedFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetDeputy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetDeputy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetDeputy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeputyAddress) > 0 {
+		i -= len(m.DeputyAddress)
+		copy(dAtA[i:], m.DeputyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeputyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetDeputyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetDeputyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetDeputyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetActive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetActive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetActive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetActiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetActiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetActiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FixedFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Limits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAssetDeputy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeputyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAssetDeputyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAssetActive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAssetActiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAssetLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAssetLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetDeputy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetDeputy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetDeputy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeputyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeputyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetDeputyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetDeputyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetDeputyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetActive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetActive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetActive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetActiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetActiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetActiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+)
+
+// NewAssetUsage returns the usage of the asset limits by the given supply,
+// which is empty for an asset whose supply is not created yet
+func NewAssetUsage(asset htlctypes.AssetParam, supply htlctypes.AssetSupply) AssetUsage {
+	current := amountOf(supply.CurrentSupply)
+	incoming := amountOf(supply.IncomingSupply)
+	limit := asset.SupplyLimit.Limit
+	used := current.Add(incoming)
+
+	available := sdk.ZeroInt()
+	if used.LT(limit) {
+		available = limit.Sub(used)
+	}
+
+	usage := AssetUsage{
+		Denom:                    asset.Denom,
+		Active:                   asset.Active,
+		DeputyAddress:            asset.DeputyAddress,
+		SupplyLimit:              limit,
+		CurrentSupply:            current,
+		IncomingSupply:           incoming,
+		OutgoingSupply:           amountOf(supply.OutgoingSupply),
+		Available:                available,
+		Usage:                    ratio(used, limit),
+		TimeLimited:              asset.SupplyLimit.TimeLimited,
+		TimeBasedLimit:           sdk.ZeroInt(),
+		TimeLimitedCurrentSupply: sdk.ZeroInt(),
+		TimeLimitedUsage:         sdk.ZeroDec(),
+	}
+	if asset.SupplyLimit.TimeLimited {
+		timeLimitedCurrent := amountOf(supply.TimeLimitedCurrentSupply)
+		usage.TimeBasedLimit = asset.SupplyLimit.TimeBasedLimit
+		usage.TimeLimitedCurrentSupply = timeLimitedCurrent
+		usage.TimeLimitedUsage = ratio(timeLimitedCurrent, asset.SupplyLimit.TimeBasedLimit)
+		usage.TimeElapsed = supply.TimeElapsed
+		usage.TimePeriod = asset.SupplyLimit.TimePeriod
+	}
+	return usage
+}
+
+func amountOf(coin sdk.Coin) sdk.Int {
+	if coin.Amount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return coin.Amount
+}
+
+// ratio returns used/limit, a limit of zero being fully used
+func ratio(used, limit sdk.Int) sdk.Dec {
+	if !limit.IsPositive() {
+		return sdk.OneDec()
+	}
+	return sdk.NewDecFromInt(used).QuoInt(limit)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+)
+
+func TestNewAssetUsage(t *testing.T) {
+	asset := testAsset()
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(asset.Denom, amount) }
+
+	usage := NewAssetUsage(asset, htlctypes.NewAssetSupply(coin(100), coin(30), coin(150), coin(40), time.Hour))
+	require.Equal(t, sdk.NewInt(750), usage.Available)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), usage.Usage)
+	require.Equal(t, sdk.NewInt(30), usage.OutgoingSupply)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), usage.TimeLimitedUsage)
+	require.Equal(t, time.Hour, usage.TimeElapsed)
+	require.Equal(t, 24*time.Hour, usage.TimePeriod)
+
+	// the supply of a new asset is not created until the next block
+	usage = NewAssetUsage(asset, htlctypes.AssetSupply{})
+	require.Equal(t, asset.SupplyLimit.Limit, usage.Available)
+	require.True(t, usage.Usage.IsZero())
+
+	// an asset above its limit has nothing available
+	asset.SupplyLimit.TimeLimited = false
+	usage = NewAssetUsage(asset, htlctypes.NewAssetSupply(coin(100), coin(0), coin(1000), coin(0), 0))
+	require.True(t, usage.Available.IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(11, 1), usage.Usage)
+	require.True(t, usage.TimeLimitedUsage.IsZero())
+}
@@ -0,0 +1,23 @@
+// nolint
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagMaxOutflow = "max-outflow"
+	FlagMaxInflow  = "max-inflow"
+	FlagPeriod     = "period"
+)
+
+// common flagsets to add to various functions
+var (
+	FsSetRateLimit = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsSetRateLimit.String(FlagMaxOutflow, "0", "maximum amount allowed to leave the chain per window, 0 for no limit")
+	FsSetRateLimit.String(FlagMaxInflow, "0", "maximum amount allowed to enter the chain per window, 0 for no limit")
+	FsSetRateLimit.Duration(FlagPeriod, 0, "length of the window, e.g. 24h")
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+// NewTxCmd returns the transaction commands for the ratelimit module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ratelimit transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSetRateLimit(),
+		GetCmdRemoveRateLimit(),
+		GetCmdResetRateLimit(),
+	)
+	return txCmd
+}
+
+// GetCmdSetRateLimit implements the set rate limit command.
+func GetCmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [channel-id] [denom]",
+		Short: "Add or update the rate limit of a denom over a channel",
+		Example: fmt.Sprintf(
+			"%s tx ratelimit set-rate-limit channel-0 ufury --max-outflow=1000000000 --max-inflow=1000000000 --period=24h --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxOutflowStr, _ := cmd.Flags().GetString(FlagMaxOutflow)
+			maxOutflow, ok := sdk.NewIntFromString(maxOutflowStr)
+			if !ok {
+				return fmt.Errorf("invalid max outflow: %s", maxOutflowStr)
+			}
+			maxInflowStr, _ := cmd.Flags().GetString(FlagMaxInflow)
+			maxInflow, ok := sdk.NewIntFromString(maxInflowStr)
+			if !ok {
+				return fmt.Errorf("invalid max inflow: %s", maxInflowStr)
+			}
+			period, _ := cmd.Flags().GetDuration(FlagPeriod)
+			if period.Seconds() < 1 {
+				return fmt.Errorf("period must be at least one second")
+			}
+
+			quota := types.NewQuota(maxOutflow, maxInflow, uint64(period.Seconds()))
+			msg := types.NewMsgSetRateLimit(args[0], args[1], quota, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSetRateLimit)
+	_ = cmd.MarkFlagRequired(FlagPeriod)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveRateLimit implements the remove rate limit command.
+func GetCmdRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom]",
+		Short: "Remove the rate limit of a denom over a channel",
+		Example: fmt.Sprintf(
+			"%s tx ratelimit remove-rate-limit channel-0 ufury --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveRateLimit(args[0], args[1], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdResetRateLimit implements the reset rate limit command.
+func GetCmdResetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit [channel-id] [denom]",
+		Short: "Reset the flow of a denom over a channel and start a new window",
+		Example: fmt.Sprintf(
+			"%s tx ratelimit reset-rate-limit channel-0 ufury --from=<key-name> --chain-id=<chain-id> --fees=0.3fury",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetRateLimit(args[0], args[1], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/ratelimit/keeper"
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize ratelimit genesis state: %s", err.Error()))
+	}
+	for _, rl := range data.RateLimits {
+		keeper.SetRateLimit(ctx, rl)
+	}
+	for _, packet := range data.PendingSendPackets {
+		keeper.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(
+		ctx,
+		func(rl types.RateLimit) bool {
+			rateLimits = append(rateLimits, rl)
+			return false
+		},
+	)
+
+	var pendingSendPackets []types.PendingSendPacket
+	k.IteratePendingSendPackets(
+		ctx,
+		func(packet types.PendingSendPacket) bool {
+			pendingSendPackets = append(pendingSendPackets, packet)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(rateLimits, pendingSendPackets)
+}
@@ -0,0 +1,89 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/furynet/furyhub/modules/ratelimit"
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+	"github.com/furynet/furyhub/simapp"
+)
+
+// transferApp acknowledges every packet successfully
+type transferApp struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (app *transferApp) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	app.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (app *transferApp) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (app *transferApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+func newPacket(sequence uint64, denom, amount string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, "transfer", "channel-7", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	voucher := types.ReceiveDenom("transfer", "channel-7", "transfer", "channel-0", "uatom")
+	app.RateLimitKeeper.UpdateRateLimit(ctx, "channel-0", voucher, types.NewQuota(sdk.ZeroInt(), sdk.NewInt(100), 3600))
+
+	underlying := &transferApp{}
+	middleware := ratelimit.NewIBCMiddleware(underlying, app.RateLimitKeeper)
+
+	ack := middleware.OnRecvPacket(ctx, newPacket(1, "uatom", "100"), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 1, underlying.received)
+
+	ack = middleware.OnRecvPacket(ctx, newPacket(2, "uatom", "1"), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, underlying.received)
+
+	// tokens without a rate limit are passed through
+	ack = middleware.OnRecvPacket(ctx, newPacket(3, "uosmo", "1000"), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 2, underlying.received)
+}
+
+func TestOnTimeoutPacket(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.RateLimitKeeper
+
+	k.UpdateRateLimit(ctx, "channel-7", "ufury", types.NewQuota(sdk.NewInt(100), sdk.ZeroInt(), 3600))
+	require.NoError(t, k.CheckAndUpdateFlow(ctx, types.Outflow, "channel-7", "ufury", sdk.NewInt(100)))
+	k.SetPendingSendPacket(ctx, types.NewPendingSendPacket("channel-7", 1, ctx.BlockTime().Unix()))
+
+	middleware := ratelimit.NewIBCMiddleware(&transferApp{}, k)
+
+	// sent packets carry the source channel of this chain
+	data := transfertypes.NewFungibleTokenPacketData("ufury", "100", "sender", "receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-7", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+
+	rl, _ := k.GetRateLimit(ctx, "channel-7", "ufury")
+	require.True(t, rl.Flow.Outflow.IsZero())
+}
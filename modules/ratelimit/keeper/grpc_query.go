@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimits implements the Query/RateLimits gRPC method, the flows are
+// reported as of the current block, an elapsed window reads as empty
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRateLimitsSubspaceKey())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var rl types.RateLimit
+		k.cdc.MustUnmarshal(value, &rl)
+		rateLimits = append(rateLimits, rl.Refresh(ctx.BlockTime().Unix()))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rl, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit %s/%s not found", req.ChannelId, req.Denom)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: rl.Refresh(ctx.BlockTime().Unix())}, nil
+}
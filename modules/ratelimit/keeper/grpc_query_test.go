@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryRateLimits() {
+	suite.setRateLimit(100, 100, 3600)
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(60)))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.RateLimits(sdk.WrapSDKContext(suite.ctx), &types.QueryRateLimitsRequest{})
+	suite.NoError(err)
+	suite.Len(res.RateLimits, 1)
+	suite.Equal(sdk.NewInt(60), res.RateLimits[0].Flow.Outflow)
+
+	rlRes, err := queryClient.RateLimit(sdk.WrapSDKContext(suite.ctx), &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	suite.NoError(err)
+	suite.Equal(res.RateLimits[0], rlRes.RateLimit)
+
+	_, err = queryClient.RateLimit(sdk.WrapSDKContext(suite.ctx), &types.QueryRateLimitRequest{ChannelId: "channel-1", Denom: denom})
+	suite.Error(err)
+
+	// an elapsed window reads as empty
+	ctx := suite.ctx.WithBlockTime(now.Add(time.Hour))
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	rlRes, err = queryClient.RateLimit(sdk.WrapSDKContext(ctx), &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	suite.NoError(err)
+	suite.True(rlRes.RateLimit.Flow.Outflow.IsZero())
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface, accounting the outflow of
+// transfer packets before handing them to core IBC
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+	amount, err := types.ParseTransferAmount(data.Amount)
+	if err != nil {
+		return err
+	}
+
+	channelID := packet.GetSourceChannel()
+	denom := types.SendDenom(data.Denom)
+	if err := k.CheckAndUpdateFlow(ctx, types.Outflow, channelID, denom, amount); err != nil {
+		return err
+	}
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	if rl, found := k.GetRateLimit(ctx, channelID, denom); found {
+		k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelID, packet.GetSequence(), rl.Flow.WindowStart))
+	}
+	return nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
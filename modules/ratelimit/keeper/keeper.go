@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+// Keeper of the ratelimit store
+type Keeper struct {
+	cdc            codec.Codec
+	storeKey       storetypes.StoreKey
+	ics4Wrapper    porttypes.ICS4Wrapper
+	guardianKeeper types.GuardianKeeper
+
+	// the address capable of managing the rate limits besides the supers,
+	// usually the gov module account
+	authority string
+}
+
+// NewKeeper returns a ratelimit keeper
+func NewKeeper(
+	cdc codec.Codec,
+	key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	guardianKeeper types.GuardianKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		ics4Wrapper:    ics4Wrapper,
+		guardianKeeper: guardianKeeper,
+		authority:      authority,
+	}
+}
+
+// GetAuthority returns the address allowed to manage the rate limits besides the supers
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CanManageRateLimits returns true if the operator is the authority or a super
+func (k Keeper) CanManageRateLimits(ctx sdk.Context, operator sdk.AccAddress) bool {
+	return operator.String() == k.authority || k.guardianKeeper.Authorized(ctx, operator)
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/ratelimit/keeper"
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+	"github.com/furynet/furyhub/simapp"
+)
+
+const (
+	channelID = "channel-0"
+	denom     = "ufury"
+)
+
+var (
+	super    = sdk.AccAddress([]byte("test-super-address"))
+	stranger = sdk.AccAddress([]byte("test-stranger-addr"))
+	now      = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	app    *simapp.SimApp
+	keeper keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	suite.app = app
+	suite.keeper = app.RateLimitKeeper
+
+	app.GuardianKeeper.AddSuper(suite.ctx, guardiantypes.NewSuper("test", guardiantypes.Ordinary, super, super))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) setRateLimit(maxOutflow, maxInflow int64, period uint64) {
+	quota := types.NewQuota(sdk.NewInt(maxOutflow), sdk.NewInt(maxInflow), period)
+	suite.keeper.UpdateRateLimit(suite.ctx, channelID, denom, quota)
+}
+
+func (suite *KeeperTestSuite) TestCheckAndUpdateFlow() {
+	// transfers of denoms without a rate limit are not restricted
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(1000)))
+
+	suite.setRateLimit(100, 0, 3600)
+
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(60)))
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(40)))
+	err := suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(1))
+	suite.ErrorIs(err, types.ErrQuotaExceeded)
+
+	// a zero quota leaves the direction unlimited
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Inflow, channelID, denom, sdk.NewInt(1000)))
+
+	rl, found := suite.keeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.True(found)
+	suite.Equal(sdk.NewInt(100), rl.Flow.Outflow)
+	suite.Equal(sdk.NewInt(1000), rl.Flow.Inflow)
+
+	// the other channels are not affected
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, "channel-1", denom, sdk.NewInt(1000)))
+}
+
+func (suite *KeeperTestSuite) TestWindow() {
+	suite.setRateLimit(100, 100, 3600)
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(100)))
+
+	ctx := suite.ctx.WithBlockTime(now.Add(59 * time.Minute))
+	suite.ErrorIs(suite.keeper.CheckAndUpdateFlow(ctx, types.Outflow, channelID, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+
+	ctx = suite.ctx.WithBlockTime(now.Add(time.Hour))
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(ctx, types.Outflow, channelID, denom, sdk.NewInt(100)))
+
+	rl, _ := suite.keeper.GetRateLimit(ctx, channelID, denom)
+	suite.Equal(now.Add(time.Hour).Unix(), rl.Flow.WindowStart)
+	suite.Equal(sdk.NewInt(100), rl.Flow.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimitKeepsFlow() {
+	suite.setRateLimit(100, 100, 3600)
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(80)))
+
+	suite.setRateLimit(50, 100, 3600)
+	rl, _ := suite.keeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Equal(sdk.NewInt(50), rl.Quota.MaxOutflow)
+	suite.Equal(sdk.NewInt(80), rl.Flow.Outflow)
+	suite.ErrorIs(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+}
+
+func (suite *KeeperTestSuite) TestResetRateLimit() {
+	suite.ErrorIs(suite.keeper.ResetRateLimit(suite.ctx, channelID, denom), types.ErrRateLimitNotFound)
+
+	suite.setRateLimit(100, 100, 3600)
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(100)))
+
+	ctx := suite.ctx.WithBlockTime(now.Add(time.Minute))
+	suite.NoError(suite.keeper.ResetRateLimit(ctx, channelID, denom))
+
+	rl, _ := suite.keeper.GetRateLimit(ctx, channelID, denom)
+	suite.Equal(types.NewFlow(now.Add(time.Minute).Unix()), rl.Flow)
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(ctx, types.Outflow, channelID, denom, sdk.NewInt(100)))
+}
+
+func (suite *KeeperTestSuite) TestRevertSendPacket() {
+	suite.setRateLimit(100, 100, 3600)
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(100)))
+	suite.keeper.SetPendingSendPacket(suite.ctx, types.NewPendingSendPacket(channelID, 1, now.Unix()))
+
+	// unknown packets are ignored
+	suite.keeper.RevertSendPacket(suite.ctx, channelID, 2, denom, sdk.NewInt(100))
+	rl, _ := suite.keeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Equal(sdk.NewInt(100), rl.Flow.Outflow)
+
+	suite.keeper.RevertSendPacket(suite.ctx, channelID, 1, denom, sdk.NewInt(60))
+	rl, _ = suite.keeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Equal(sdk.NewInt(40), rl.Flow.Outflow)
+
+	_, found := suite.keeper.GetPendingSendPacket(suite.ctx, channelID, 1)
+	suite.False(found)
+
+	// packets sent in an elapsed window don't free the quota of the current one
+	suite.keeper.SetPendingSendPacket(suite.ctx, types.NewPendingSendPacket(channelID, 3, now.Add(-time.Hour).Unix()))
+	suite.keeper.RevertSendPacket(suite.ctx, channelID, 3, denom, sdk.NewInt(40))
+	rl, _ = suite.keeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Equal(sdk.NewInt(40), rl.Flow.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	quota := types.NewQuota(sdk.NewInt(100), sdk.NewInt(100), 3600)
+
+	_, err := msgServer.SetRateLimit(ctx, types.NewMsgSetRateLimit(channelID, denom, quota, stranger))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+
+	_, err = msgServer.SetRateLimit(ctx, types.NewMsgSetRateLimit(channelID, denom, quota, super))
+	suite.NoError(err)
+
+	suite.NoError(suite.keeper.CheckAndUpdateFlow(suite.ctx, types.Outflow, channelID, denom, sdk.NewInt(100)))
+
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.ResetRateLimit(ctx, types.NewMsgResetRateLimit(channelID, denom, stranger))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+	_, err = msgServer.ResetRateLimit(ctx, types.NewMsgResetRateLimit(channelID, denom, gov))
+	suite.NoError(err)
+
+	rl, _ := suite.keeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.True(rl.Flow.Outflow.IsZero())
+
+	_, err = msgServer.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(channelID, denom, gov))
+	suite.NoError(err)
+	_, err = msgServer.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(channelID, denom, gov))
+	suite.ErrorIs(err, types.ErrRateLimitNotFound)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.CanManageRateLimits(ctx, operator) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+
+	m.Keeper.UpdateRateLimit(ctx, msg.ChannelId, msg.Denom, msg.Quota())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxOutflow, msg.MaxOutflow.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxInflow, msg.MaxInflow.String()),
+			sdk.NewAttribute(types.AttributeKeyPeriod, sdk.NewIntFromUint64(msg.PeriodSeconds).String()),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+func (m msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.CanManageRateLimits(ctx, operator) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+	if _, found := m.Keeper.GetRateLimit(ctx, msg.ChannelId, msg.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s/%s", msg.ChannelId, msg.Denom)
+	}
+
+	m.Keeper.RemoveRateLimit(ctx, msg.ChannelId, msg.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+func (m msgServer) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.CanManageRateLimits(ctx, operator) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Operator)
+	}
+	if err := m.Keeper.ResetRateLimit(ctx, msg.ChannelId, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+		sdk.NewEvent(
+			types.EventTypeResetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+	})
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furynet/furyhub/modules/ratelimit/types"
+)
+
+// SetRateLimit stores the given rate limit
+func (k Keeper) SetRateLimit(ctx sdk.Context, rl types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rl)
+	store.Set(types.GetRateLimitKey(rl.ChannelId, rl.Denom), bz)
+}
+
+// RemoveRateLimit deletes the rate limit of the given channel and denom
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRateLimitKey(channelID, denom))
+}
+
+// GetRateLimit retrieves the rate limit of the given channel and denom
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (rl types.RateLimit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetRateLimitKey(channelID, denom)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &rl)
+		return rl, true
+	}
+	return rl, false
+}
+
+// IterateRateLimits iterates through all rate limits
+func (k Keeper) IterateRateLimits(
+	ctx sdk.Context,
+	op func(rl types.RateLimit) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRateLimitsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rl types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rl)
+
+		if stop := op(rl); stop {
+			break
+		}
+	}
+}
+
+// UpdateRateLimit sets the quota of the given channel and denom, keeping the
+// flow of the current window if the rate limit already exists
+func (k Keeper) UpdateRateLimit(ctx sdk.Context, channelID, denom string, quota types.Quota) types.RateLimit {
+	rl, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		rl = types.NewRateLimit(channelID, denom, quota, ctx.BlockTime().Unix())
+	}
+	rl.Quota = quota
+	k.SetRateLimit(ctx, rl)
+	return rl
+}
+
+// ResetRateLimit clears the flow of the given channel and denom and starts a new window
+func (k Keeper) ResetRateLimit(ctx sdk.Context, channelID, denom string) error {
+	rl, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s/%s", channelID, denom)
+	}
+	rl.Flow = types.NewFlow(ctx.BlockTime().Unix())
+	k.SetRateLimit(ctx, rl)
+	return nil
+}
+
+// CheckAndUpdateFlow accounts a transfer of the given denom over the channel,
+// failing if it would exceed the quota. Transfers of denoms without a rate
+// limit are always allowed.
+func (k Keeper) CheckAndUpdateFlow(
+	ctx sdk.Context,
+	direction types.Direction,
+	channelID, denom string,
+	amount sdk.Int,
+) error {
+	rl, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+	rl, err := rl.Refresh(ctx.BlockTime().Unix()).AddFlow(direction, amount)
+	if err != nil {
+		return err
+	}
+	k.SetRateLimit(ctx, rl)
+	return nil
+}
+
+// SetPendingSendPacket stores the given pending send packet
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.GetPendingSendPacketKey(packet.ChannelId, packet.Sequence), bz)
+}
+
+// GetPendingSendPacket retrieves the pending send packet of the given channel and sequence
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (packet types.PendingSendPacket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetPendingSendPacketKey(channelID, sequence)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &packet)
+		return packet, true
+	}
+	return packet, false
+}
+
+// DeletePendingSendPacket deletes the pending send packet of the given channel and sequence
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// IteratePendingSendPackets iterates through all pending send packets
+func (k Keeper) IteratePendingSendPackets(
+	ctx sdk.Context,
+	op func(packet types.PendingSendPacket) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		if stop := op(packet); stop {
+			break
+		}
+	}
+}
+
+// RevertSendPacket reverts the outflow of a sent transfer that failed or timed
+// out. The outflow is only reverted while the window it was accounted in is
+// still current, older windows have already been discarded.
+func (k Keeper) RevertSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string, amount sdk.Int) {
+	pending, found := k.GetPendingSendPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, channelID, sequence)
+
+	rl, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found || rl.Flow.WindowStart != pending.WindowStart {
+		return
+	}
+	k.SetRateLimit(ctx, rl.SubOutflow(amount))
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ratelimit module sentinel errors
+var (
+	ErrUnknownOperator    = sdkerrors.Register(ModuleName, 2, "unknown operator")
+	ErrInvalidQuota       = sdkerrors.Register(ModuleName, 3, "invalid quota")
+	ErrRateLimitNotFound  = sdkerrors.Register(ModuleName, 4, "rate limit not found")
+	ErrQuotaExceeded      = sdkerrors.Register(ModuleName, 5, "quota exceeded")
+	ErrInvalidTransferAmt = sdkerrors.Register(ModuleName, 6, "invalid transfer amount")
+)
@@ -0,0 +1,20 @@
+// nolint
+package types
+
+// ratelimit module event types
+const (
+	EventTypeSetRateLimit    = "set_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+
+	AttributeKeyDenom      = "denom"
+	AttributeKeyChannelID  = "channel_id"
+	AttributeKeyMaxOutflow = "max_outflow"
+	AttributeKeyMaxInflow  = "max_inflow"
+	AttributeKeyPeriod     = "period_seconds"
+	AttributeKeyOperator   = "operator"
+	AttributeKeyDirection  = "direction"
+	AttributeKeyAmount     = "amount"
+
+	AttributeValueCategory = ModuleName
+)
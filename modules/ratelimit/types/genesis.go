@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis validates the provided ratelimit genesis state
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.RateLimits))
+	for _, rl := range data.RateLimits {
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+		key := string(GetRateLimitKey(rl.ChannelId, rl.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit %s/%s", rl.ChannelId, rl.Denom)
+		}
+		seen[key] = true
+	}
+	seenPackets := make(map[string]bool, len(data.PendingSendPackets))
+	for _, packet := range data.PendingSendPackets {
+		if err := ValidateChannelID(packet.ChannelId); err != nil {
+			return err
+		}
+		key := string(GetPendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicate pending send packet %s/%d", packet.ChannelId, packet.Sequence)
+		}
+		seenPackets[key] = true
+	}
+	return nil
+}
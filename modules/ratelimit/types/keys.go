@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the default store key for ratelimit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for ratelimit
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ratelimit store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	RateLimitKey         = []byte{0x01} // rate limit key
+	PendingSendPacketKey = []byte{0x02} // pending send packet key
+
+	// separates the channel id from the rest of the key, channel identifiers
+	// never contain it
+	keySeparator = []byte("/")
+)
+
+// GetRateLimitKey returns the rate limit key bytes of the given channel and denom
+func GetRateLimitKey(channelID, denom string) []byte {
+	key := append(append([]byte{}, RateLimitKey...), channelID...)
+	key = append(key, keySeparator...)
+	return append(key, denom...)
+}
+
+// GetRateLimitsSubspaceKey returns the key for getting all rate limits from the store
+func GetRateLimitsSubspaceKey() []byte {
+	return RateLimitKey
+}
+
+// GetPendingSendPacketKey returns the pending send packet key bytes of the given channel and sequence
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	key := append(append([]byte{}, PendingSendPacketKey...), channelID...)
+	key = append(key, keySeparator...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetPendingSendPacketsSubspaceKey returns the key for getting all pending send packets from the store
+func GetPendingSendPacketsSubspaceKey() []byte {
+	return PendingSendPacketKey
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetRateLimit    = "set_rate_limit"    // type for MsgSetRateLimit
+	TypeMsgRemoveRateLimit = "remove_rate_limit" // type for MsgRemoveRateLimit
+	TypeMsgResetRateLimit  = "reset_rate_limit"  // type for MsgResetRateLimit
+)
+
+var (
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+// NewMsgSetRateLimit constructs a MsgSetRateLimit
+func NewMsgSetRateLimit(channelID, denom string, quota Quota, operator sdk.AccAddress) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Denom:         denom,
+		ChannelId:     channelID,
+		MaxOutflow:    quota.MaxOutflow,
+		MaxInflow:     quota.MaxInflow,
+		PeriodSeconds: quota.PeriodSeconds,
+		Operator:      operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetRateLimit) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetRateLimit) Type() string { return TypeMsgSetRateLimit }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetRateLimit) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	if err := validateRateLimitMsg(msg.ChannelId, msg.Denom, msg.Operator); err != nil {
+		return err
+	}
+	return msg.Quota().Validate()
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// Quota returns the quota carried by the message
+func (msg MsgSetRateLimit) Quota() Quota {
+	return NewQuota(msg.MaxOutflow, msg.MaxInflow, msg.PeriodSeconds)
+}
+
+// ______________________________________________________________________
+
+// NewMsgRemoveRateLimit constructs a MsgRemoveRateLimit
+func NewMsgRemoveRateLimit(channelID, denom string, operator sdk.AccAddress) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Denom:     denom,
+		ChannelId: channelID,
+		Operator:  operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRemoveRateLimit) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRemoveRateLimit) Type() string { return TypeMsgRemoveRateLimit }
+
+// GetSignBytes implements Msg.
+func (msg MsgRemoveRateLimit) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	return validateRateLimitMsg(msg.ChannelId, msg.Denom, msg.Operator)
+}
+
+// GetSigners implements Msg.
+func (msg MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgResetRateLimit constructs a MsgResetRateLimit
+func NewMsgResetRateLimit(channelID, denom string, operator sdk.AccAddress) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Denom:     denom,
+		ChannelId: channelID,
+		Operator:  operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgResetRateLimit) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgResetRateLimit) Type() string { return TypeMsgResetRateLimit }
+
+// GetSignBytes implements Msg.
+func (msg MsgResetRateLimit) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	return validateRateLimitMsg(msg.ChannelId, msg.Denom, msg.Operator)
+}
+
+// GetSigners implements Msg.
+func (msg MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func validateRateLimitMsg(channelID, denom, operator string) error {
+	if err := ValidateChannelID(channelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var operator = sdk.AccAddress([]byte("test-operator-addr"))
+
+func TestMsgSetRateLimitValidateBasic(t *testing.T) {
+	quota := NewQuota(sdk.NewInt(100), sdk.NewInt(100), 3600)
+
+	tests := []struct {
+		name    string
+		msg     *MsgSetRateLimit
+		expPass bool
+	}{
+		{"valid", NewMsgSetRateLimit("channel-0", "ufury", quota, operator), true},
+		{"ibc denom", NewMsgSetRateLimit("channel-0", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", quota, operator), true},
+		{"invalid channel", NewMsgSetRateLimit("channel 0", "ufury", quota, operator), false},
+		{"invalid denom", NewMsgSetRateLimit("channel-0", "f", quota, operator), false},
+		{"invalid operator", &MsgSetRateLimit{ChannelId: "channel-0", Denom: "ufury", MaxOutflow: quota.MaxOutflow, MaxInflow: quota.MaxInflow, PeriodSeconds: 1}, false},
+		{"zero period", NewMsgSetRateLimit("channel-0", "ufury", NewQuota(sdk.NewInt(1), sdk.NewInt(1), 0), operator), false},
+		{"negative quota", NewMsgSetRateLimit("channel-0", "ufury", NewQuota(sdk.NewInt(-1), sdk.NewInt(1), 1), operator), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgResetRateLimitValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgResetRateLimit("channel-0", "ufury", operator).ValidateBasic())
+	require.Error(t, NewMsgResetRateLimit("", "ufury", operator).ValidateBasic())
+	require.NoError(t, NewMsgRemoveRateLimit("channel-0", "ufury", operator).ValidateBasic())
+	require.Error(t, NewMsgRemoveRateLimit("channel-0", "", operator).ValidateBasic())
+}
+
+func TestMsgSetRateLimitGetSignBytes(t *testing.T) {
+	quota := NewQuota(sdk.NewInt(100), sdk.NewInt(0), 3600)
+	msg := NewMsgSetRateLimit("channel-0", "ufury", quota, operator)
+	require.Contains(t, string(msg.GetSignBytes()), `"max_outflow":"100"`)
+	require.Equal(t, []sdk.AccAddress{operator}, msg.GetSigners())
+}
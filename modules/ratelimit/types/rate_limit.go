@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// Direction defines the direction of a transfer relative to this chain
+type Direction string
+
+const (
+	// Outflow is a transfer leaving the chain
+	Outflow Direction = "outflow"
+	// Inflow is a transfer entering the chain
+	Inflow Direction = "inflow"
+)
+
+// NewQuota constructs a Quota
+func NewQuota(maxOutflow, maxInflow sdk.Int, periodSeconds uint64) Quota {
+	return Quota{
+		MaxOutflow:    maxOutflow,
+		MaxInflow:     maxInflow,
+		PeriodSeconds: periodSeconds,
+	}
+}
+
+// Validate checks the quota is well formed
+func (q Quota) Validate() error {
+	if q.MaxOutflow.IsNil() || q.MaxOutflow.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max outflow must not be negative: %s", q.MaxOutflow)
+	}
+	if q.MaxInflow.IsNil() || q.MaxInflow.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max inflow must not be negative: %s", q.MaxInflow)
+	}
+	if q.PeriodSeconds == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "period must be positive")
+	}
+	return nil
+}
+
+// Max returns the maximum amount allowed in the given direction, zero means unlimited
+func (q Quota) Max(direction Direction) sdk.Int {
+	if direction == Outflow {
+		return q.MaxOutflow
+	}
+	return q.MaxInflow
+}
+
+// NewFlow constructs an empty Flow starting at the given unix time
+func NewFlow(windowStart int64) Flow {
+	return Flow{
+		Outflow:     sdk.ZeroInt(),
+		Inflow:      sdk.ZeroInt(),
+		WindowStart: windowStart,
+	}
+}
+
+// Amount returns the amount transferred in the given direction
+func (f Flow) Amount(direction Direction) sdk.Int {
+	if direction == Outflow {
+		return f.Outflow
+	}
+	return f.Inflow
+}
+
+// NewRateLimit constructs a RateLimit with an empty flow
+func NewRateLimit(channelID, denom string, quota Quota, windowStart int64) RateLimit {
+	return RateLimit{
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+		Flow:      NewFlow(windowStart),
+	}
+}
+
+// Validate checks the rate limit is well formed
+func (rl RateLimit) Validate() error {
+	if err := ValidateChannelID(rl.ChannelId); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+	if rl.Flow.Outflow.IsNil() || rl.Flow.Outflow.IsNegative() ||
+		rl.Flow.Inflow.IsNil() || rl.Flow.Inflow.IsNegative() {
+		return fmt.Errorf("invalid flow of rate limit %s/%s", rl.ChannelId, rl.Denom)
+	}
+	return nil
+}
+
+// Refresh starts a new window if the current one has elapsed at the given unix time
+func (rl RateLimit) Refresh(now int64) RateLimit {
+	if now >= rl.Flow.WindowStart+int64(rl.Quota.PeriodSeconds) {
+		rl.Flow = NewFlow(now)
+	}
+	return rl
+}
+
+// AddFlow accounts the amount in the given direction, failing if it would
+// exceed the quota of the current window
+func (rl RateLimit) AddFlow(direction Direction, amount sdk.Int) (RateLimit, error) {
+	total := rl.Flow.Amount(direction).Add(amount)
+	if max := rl.Quota.Max(direction); max.IsPositive() && total.GT(max) {
+		return rl, sdkerrors.Wrapf(
+			ErrQuotaExceeded,
+			"%s of %s over %s would reach %s, quota is %s", direction, rl.Denom, rl.ChannelId, total, max,
+		)
+	}
+	if direction == Outflow {
+		rl.Flow.Outflow = total
+	} else {
+		rl.Flow.Inflow = total
+	}
+	return rl, nil
+}
+
+// SubOutflow reverts the outflow of a failed transfer
+func (rl RateLimit) SubOutflow(amount sdk.Int) RateLimit {
+	rl.Flow.Outflow = sdk.MaxInt(rl.Flow.Outflow.Sub(amount), sdk.ZeroInt())
+	return rl
+}
+
+// ValidateChannelID checks the channel identifier is well formed
+func ValidateChannelID(channelID string) error {
+	return host.ChannelIdentifierValidator(channelID)
+}
+
+// NewPendingSendPacket constructs a PendingSendPacket
+func NewPendingSendPacket(channelID string, sequence uint64, windowStart int64) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		WindowStart: windowStart,
+	}
+}
+
+// SendDenom returns the local denom of a transfer sent from this chain,
+// where denom is the full denom path carried by the packet
+func SendDenom(denom string) string {
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+// ReceiveDenom returns the local denom of a transfer received by this chain,
+// mirroring how the transfer module mints or unescrows it
+func ReceiveDenom(sourcePort, sourceChannel, destPort, destChannel, denom string) string {
+	if transfertypes.ReceiverChainIsSource(sourcePort, sourceChannel, denom) {
+		unprefixed := strings.TrimPrefix(denom, transfertypes.GetDenomPrefix(sourcePort, sourceChannel))
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+	}
+	prefixed := transfertypes.GetDenomPrefix(destPort, destChannel) + denom
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
+
+// ParseTransferAmount parses the amount of a transfer packet
+func ParseTransferAmount(amount string) (sdk.Int, error) {
+	amt, ok := sdk.NewIntFromString(amount)
+	if !ok || !amt.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalidTransferAmt, "%s", amount)
+	}
+	return amt, nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	require.NoError(t, NewQuota(sdk.NewInt(1), sdk.ZeroInt(), 1).Validate())
+	require.Error(t, NewQuota(sdk.NewInt(-1), sdk.ZeroInt(), 1).Validate())
+	require.Error(t, NewQuota(sdk.ZeroInt(), sdk.NewInt(-1), 1).Validate())
+	require.Error(t, NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 0).Validate())
+	require.Error(t, Quota{PeriodSeconds: 1}.Validate())
+}
+
+func TestSendDenom(t *testing.T) {
+	require.Equal(t, "ufury", SendDenom("ufury"))
+
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	require.Equal(t, voucher, SendDenom("transfer/channel-0/uatom"))
+}
+
+func TestReceiveDenom(t *testing.T) {
+	// a native token returning from the counterparty chain
+	require.Equal(t, "ufury", ReceiveDenom("transfer", "channel-7", "transfer", "channel-0", "transfer/channel-7/ufury"))
+
+	// a counterparty token is received as a voucher
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	require.Equal(t, voucher, ReceiveDenom("transfer", "channel-7", "transfer", "channel-0", "uatom"))
+}
+
+func TestParseTransferAmount(t *testing.T) {
+	amt, err := ParseTransferAmount("100")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), amt)
+
+	for _, amount := range []string{"", "0", "-1", "abc"} {
+		_, err := ParseTransferAmount(amount)
+		require.ErrorIs(t, err, ErrInvalidTransferAmt)
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	quota := NewQuota(sdk.NewInt(100), sdk.NewInt(100), 3600)
+	rl := NewRateLimit("channel-0", "ufury", quota, 0)
+
+	require.NoError(t, ValidateGenesis(*NewGenesisState([]RateLimit{rl}, nil)))
+	require.Error(t, ValidateGenesis(*NewGenesisState([]RateLimit{rl, rl}, nil)))
+
+	invalid := NewRateLimit("channel 0", "ufury", quota, 0)
+	require.Error(t, ValidateGenesis(*NewGenesisState([]RateLimit{invalid}, nil)))
+
+	packet := NewPendingSendPacket("channel-0", 1, 0)
+	require.Error(t, ValidateGenesis(*NewGenesisState(nil, []PendingSendPacket{packet, packet})))
+}
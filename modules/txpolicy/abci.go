@@ -0,0 +1,13 @@
+package txpolicy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/txpolicy/keeper"
+)
+
+// BeginBlocker prunes the tx counts of the expired rate limit windows
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	k.PruneTxCounts(ctx, params.WindowStart(ctx.BlockHeight()))
+}
@@ -0,0 +1,23 @@
+package txpolicy
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/txpolicy/keeper"
+	"github.com/furynet/furyhub/modules/txpolicy/types"
+)
+
+// InitGenesis stores the txpolicy genesis params
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize txpolicy genesis state: %s", err.Error()))
+	}
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
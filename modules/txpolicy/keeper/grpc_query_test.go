@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+
+	"github.com/furynet/furyhub/modules/txpolicy/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryParams() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TxPolicyKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	resp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(app.TxPolicyKeeper.GetParams(ctx), resp.Params)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/furynet/furyhub/modules/txpolicy/types"
+)
+
+// Keeper of the txpolicy store
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a txpolicy keeper
+func NewKeeper(key storetypes.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   key,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the active transaction policy. Params which have not been
+// stored yet (e.g. before the module is initialized by an upgrade) fall back to
+// their default value, so the ante handler keeps its historical behaviour.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the transaction policy
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/txpolicy/types"
+	"github.com/furynet/furyhub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParams() {
+	suite.Equal(types.DefaultParams(), suite.app.TxPolicyKeeper.GetParams(suite.ctx))
+
+	params := types.NewParams(true, []string{"/cosmos.gov.v1.MsgDeposit"}, []string{"lpt", "farm"}, 5, 20, true, []string{"gov"})
+	suite.app.TxPolicyKeeper.SetParams(suite.ctx, params)
+	suite.Equal(params, suite.app.TxPolicyKeeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furynet/furyhub/modules/txpolicy/types"
+)
+
+// GetTxCount returns the number of txs sent by addr in the window starting at windowStart
+func (k Keeper) GetTxCount(ctx sdk.Context, windowStart uint64, addr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return types.ParseTxCount(store.Get(types.GetTxCountKey(windowStart, addr)))
+}
+
+// IncrementTxCount increments the tx count of addr in the window starting at
+// windowStart and returns the new count
+func (k Keeper) IncrementTxCount(ctx sdk.Context, windowStart uint64, addr sdk.AccAddress) uint64 {
+	count := k.GetTxCount(ctx, windowStart, addr) + 1
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTxCountKey(windowStart, addr), sdk.Uint64ToBigEndian(count))
+	return count
+}
+
+// PruneTxCounts deletes the tx counts of all windows starting before windowStart
+func (k Keeper) PruneTxCounts(ctx sdk.Context, windowStart uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.TxCountKey, types.GetTxCountWindowKey(windowStart))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
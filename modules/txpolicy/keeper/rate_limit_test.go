@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestTxCounts() {
+	k, ctx := suite.app.TxPolicyKeeper, suite.ctx
+	addr := sdk.AccAddress([]byte("rate_limited_address"))
+
+	suite.Equal(uint64(0), k.GetTxCount(ctx, 10, addr))
+	suite.Equal(uint64(1), k.IncrementTxCount(ctx, 10, addr))
+	suite.Equal(uint64(2), k.IncrementTxCount(ctx, 10, addr))
+	suite.Equal(uint64(1), k.IncrementTxCount(ctx, 20, addr))
+
+	k.PruneTxCounts(ctx, 20)
+	suite.Equal(uint64(0), k.GetTxCount(ctx, 10, addr))
+	suite.Equal(uint64(1), k.GetTxCount(ctx, 20, addr))
+}
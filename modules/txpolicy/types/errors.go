@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// txpolicy module sentinel errors
+var (
+	ErrRateLimited = sdkerrors.Register(ModuleName, 2, "transaction rate limit exceeded")
+)
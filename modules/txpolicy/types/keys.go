@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "txpolicy"
+
+	// StoreKey is the default store key for txpolicy
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the txpolicy querier
+	QueryParameters = "parameters"
+)
+
+var (
+	TxCountKey = []byte{0x01} // tx count key of the rate limit
+)
+
+// GetTxCountWindowKey returns the key prefix of the tx counts of the window starting at windowStart
+func GetTxCountWindowKey(windowStart uint64) []byte {
+	return append(TxCountKey, sdk.Uint64ToBigEndian(windowStart)...)
+}
+
+// GetTxCountKey returns the key of the tx count of addr in the window starting at windowStart
+func GetTxCountKey(windowStart uint64, addr sdk.AccAddress) []byte {
+	return append(GetTxCountWindowKey(windowStart), addr.Bytes()...)
+}
+
+// ParseTxCount decodes a stored tx count
+func ParseTxCount(bz []byte) uint64 {
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,236 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+
+	// DefaultWindowBlocks is the default length of the rate limit window
+	DefaultWindowBlocks uint64 = 10
+)
+
+// Parameter store key
+var (
+	KeyAllowRepeatedService    = []byte("AllowRepeatedService")
+	KeyLptRestrictedMsgTypes   = []byte("LptRestrictedMsgTypes")
+	KeyIBCBlockedDenomPrefixes = []byte("IBCBlockedDenomPrefixes")
+	KeyMaxTxsPerWindow         = []byte("MaxTxsPerWindow")
+	KeyWindowBlocks            = []byte("WindowBlocks")
+	KeyEnforceInDeliverTx      = []byte("EnforceInDeliverTx")
+	KeyRateLimitExemptModules  = []byte("RateLimitExemptModules")
+)
+
+// ParamKeyTable for txpolicy module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	allowRepeatedService bool,
+	lptRestrictedMsgTypes, ibcBlockedDenomPrefixes []string,
+	maxTxsPerWindow, windowBlocks uint64,
+	enforceInDeliverTx bool,
+	rateLimitExemptModules []string,
+) Params {
+	return Params{
+		AllowRepeatedService:    allowRepeatedService,
+		LptRestrictedMsgTypes:   lptRestrictedMsgTypes,
+		IbcBlockedDenomPrefixes: ibcBlockedDenomPrefixes,
+		MaxTxsPerWindow:         maxTxsPerWindow,
+		WindowBlocks:            windowBlocks,
+		EnforceInDeliverTx:      enforceInDeliverTx,
+		RateLimitExemptModules:  rateLimitExemptModules,
+	}
+}
+
+// DefaultParams returns the policy that was previously hard-coded in the ante handler
+func DefaultParams() Params {
+	return Params{
+		AllowRepeatedService: false,
+		LptRestrictedMsgTypes: []string{
+			sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+			sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+		},
+		IbcBlockedDenomPrefixes: []string{coinswaptypes.LptTokenPrefix},
+		MaxTxsPerWindow:         0,
+		WindowBlocks:            DefaultWindowBlocks,
+		EnforceInDeliverTx:      false,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowRepeatedService, &p.AllowRepeatedService, validateAllowRepeatedService),
+		paramtypes.NewParamSetPair(KeyLptRestrictedMsgTypes, &p.LptRestrictedMsgTypes, validateLptRestrictedMsgTypes),
+		paramtypes.NewParamSetPair(KeyIBCBlockedDenomPrefixes, &p.IbcBlockedDenomPrefixes, validateIBCBlockedDenomPrefixes),
+		paramtypes.NewParamSetPair(KeyMaxTxsPerWindow, &p.MaxTxsPerWindow, validateMaxTxsPerWindow),
+		paramtypes.NewParamSetPair(KeyWindowBlocks, &p.WindowBlocks, validateWindowBlocks),
+		paramtypes.NewParamSetPair(KeyEnforceInDeliverTx, &p.EnforceInDeliverTx, validateEnforceInDeliverTx),
+		paramtypes.NewParamSetPair(KeyRateLimitExemptModules, &p.RateLimitExemptModules, validateRateLimitExemptModules),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateAllowRepeatedService(p.AllowRepeatedService); err != nil {
+		return err
+	}
+	if err := validateLptRestrictedMsgTypes(p.LptRestrictedMsgTypes); err != nil {
+		return err
+	}
+	if err := validateIBCBlockedDenomPrefixes(p.IbcBlockedDenomPrefixes); err != nil {
+		return err
+	}
+	if err := validateMaxTxsPerWindow(p.MaxTxsPerWindow); err != nil {
+		return err
+	}
+	if err := validateWindowBlocks(p.WindowBlocks); err != nil {
+		return err
+	}
+	if err := validateEnforceInDeliverTx(p.EnforceInDeliverTx); err != nil {
+		return err
+	}
+	return validateRateLimitExemptModules(p.RateLimitExemptModules)
+}
+
+// IsLptRestricted returns true if the given message type can not carry liquidity tokens
+func (p Params) IsLptRestricted(msgTypeURL string) bool {
+	for _, typeURL := range p.LptRestrictedMsgTypes {
+		if typeURL == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIBCBlockedDenom returns true if the given denom can not be transferred through IBC
+func (p Params) IsIBCBlockedDenom(denom string) bool {
+	for _, prefix := range p.IbcBlockedDenomPrefixes {
+		if strings.HasPrefix(denom, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimitEnabled returns true if the per account rate limit is active
+func (p Params) RateLimitEnabled() bool {
+	return p.MaxTxsPerWindow > 0
+}
+
+// WindowStart returns the first height of the rate limit window containing
+// height. The windows are tumbling, each one starts at a multiple of
+// WindowBlocks.
+func (p Params) WindowStart(height int64) uint64 {
+	h := uint64(height)
+	return h - h%p.WindowBlocks
+}
+
+func validateAllowRepeatedService(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLptRestrictedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, typeURL := range v {
+		if !strings.HasPrefix(typeURL, "/") || len(strings.TrimSpace(typeURL)) == 1 {
+			return fmt.Errorf("invalid message type url [%s], must be of the form /package.Msg", typeURL)
+		}
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate message type url [%s]", typeURL)
+		}
+		seen[typeURL] = true
+	}
+	return nil
+}
+
+func validateIBCBlockedDenomPrefixes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, prefix := range v {
+		if strings.TrimSpace(prefix) == "" {
+			return fmt.Errorf("ibc blocked denom prefix cannot be blank")
+		}
+		if seen[prefix] {
+			return fmt.Errorf("duplicate ibc blocked denom prefix [%s]", prefix)
+		}
+		seen[prefix] = true
+	}
+	return nil
+}
+
+func validateMaxTxsPerWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateWindowBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("window blocks must be positive")
+	}
+	return nil
+}
+
+func validateEnforceInDeliverTx(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRateLimitExemptModules(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, name := range v {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("rate limit exempt module name cannot be blank")
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate rate limit exempt module [%s]", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"default", DefaultParams(), false},
+		{"empty", NewParams(true, nil, nil, 0, DefaultWindowBlocks, false, nil), false},
+		{"invalid msg type url", NewParams(false, []string{"cosmos.gov.v1.MsgDeposit"}, nil, 0, DefaultWindowBlocks, false, nil), true},
+		{"duplicate msg type url", NewParams(false, []string{"/a.MsgB", "/a.MsgB"}, nil, 0, DefaultWindowBlocks, false, nil), true},
+		{"blank denom prefix", NewParams(false, nil, []string{" "}, 0, DefaultWindowBlocks, false, nil), true},
+		{"rate limit", NewParams(false, nil, nil, 10, 5, true, []string{"gov"}), false},
+		{"zero window", NewParams(false, nil, nil, 10, 0, false, nil), true},
+		{"blank exempt module", NewParams(false, nil, nil, 10, 5, false, []string{""}), true},
+		{"duplicate exempt module", NewParams(false, nil, nil, 10, 5, false, []string{"gov", "gov"}), true},
+		{"duplicate denom prefix", NewParams(false, nil, []string{"lpt", "lpt"}, 0, DefaultWindowBlocks, false, nil), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsMatchers(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.IsLptRestricted("/cosmos.gov.v1.MsgDeposit"))
+	require.False(t, params.IsLptRestricted("/cosmos.bank.v1beta1.MsgSend"))
+	require.True(t, params.IsIBCBlockedDenom("lpt-1"))
+	require.False(t, params.IsIBCBlockedDenom("ufury"))
+	require.False(t, params.RateLimitEnabled())
+	require.Equal(t, uint64(20), params.WindowStart(29))
+	require.Equal(t, uint64(30), params.WindowStart(30))
+}
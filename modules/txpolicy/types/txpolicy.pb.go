@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: txpolicy/txpolicy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the transaction policy enforced by the ante handler
+type Params struct {
+	// whether MsgCallService may create repeated service invocations
+	AllowRepeatedService bool `protobuf:"varint,1,opt,name=allow_repeated_service,json=allowRepeatedService,proto3" json:"allow_repeated_service,omitempty" yaml:"allow_repeated_service"`
+	// type urls of the messages which can not carry coinswap liquidity tokens
+	LptRestrictedMsgTypes []string `protobuf:"bytes,2,rep,name=lpt_restricted_msg_types,json=lptRestrictedMsgTypes,proto3" json:"lpt_restricted_msg_types,omitempty" yaml:"lpt_restricted_msg_types"`
+	// denom prefixes which can not be transferred through the IBC transfer module
+	IbcBlockedDenomPrefixes []string `protobuf:"bytes,3,rep,name=ibc_blocked_denom_prefixes,json=ibcBlockedDenomPrefixes,proto3" json:"ibc_blocked_denom_prefixes,omitempty" yaml:"ibc_blocked_denom_prefixes"`
+	// maximum number of txs an account may send per window, 0 disables the rate limit
+	MaxTxsPerWindow uint64 `protobuf:"varint,4,opt,name=max_txs_per_window,json=maxTxsPerWindow,proto3" json:"max_txs_per_window,omitempty" yaml:"max_txs_per_window"`
+	// length of the rate limit window in blocks
+	WindowBlocks uint64 `protobuf:"varint,5,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// whether the rate limit is also enforced in DeliverTx, otherwise only in CheckTx
+	EnforceInDeliverTx bool `protobuf:"varint,6,opt,name=enforce_in_deliver_tx,json=enforceInDeliverTx,proto3" json:"enforce_in_deliver_tx,omitempty" yaml:"enforce_in_deliver_tx"`
+	// names of the module accounts which are exempt from the rate limit
+	RateLimitExemptModules []string `protobuf:"bytes,7,rep,name=rate_limit_exempt_modules,json=rateLimitExemptModules,proto3" json:"rate_limit_exempt_modules,omitempty" yaml:"rate_limit_exempt_modules"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6968b742e902e9f9, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowRepeatedService() bool {
+	if m != nil {
+		return m.AllowRepeatedService
+	}
+	return false
+}
+
+func (m *Params) GetLptRestrictedMsgTypes() []string {
+	if m != nil {
+		return m.LptRestrictedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetIbcBlockedDenomPrefixes() []string {
+	if m != nil {
+		return m.IbcBlockedDenomPrefixes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxTxsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEnforceInDeliverTx() bool {
+	if m != nil {
+		return m.EnforceInDeliverTx
+	}
+	return false
+}
+
+func (m *Params) GetRateLimitExemptModules() []string {
+	if m != nil {
+		return m.RateLimitExemptModules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gridiron.txpolicy.Params")
+}
+
+func init() { proto.RegisterFile("txpolicy/txpolicy.proto", fileDescriptor_6968b742e902e9f9) }
+
+var fileDescriptor_6968b742e902e9f9 = []byte{
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x6b, 0xd4, 0x40,
+	0x18, 0xc7, 0x37, 0x76, 0x5d, 0x35, 0x28, 0xe2, 0xb0, 0x6d, 0xd3, 0x62, 0x93, 0x34, 0x2a, 0xec,
+	0x69, 0x83, 0x78, 0x2b, 0x78, 0x09, 0xf5, 0xe0, 0x4b, 0x61, 0x49, 0x17, 0x0a, 0x22, 0x0c, 0x79,
+	0x79, 0x36, 0x1d, 0x9c, 0xc9, 0x84, 0x99, 0xd9, 0x6e, 0xf6, 0x5b, 0x78, 0xf4, 0xe8, 0xc7, 0xf1,
+	0xd8, 0xa3, 0xa7, 0x20, 0xbb, 0x37, 0x8f, 0xf9, 0x04, 0x92, 0x99, 0xad, 0x22, 0x76, 0x4f, 0x99,
+	0xfc, 0x7f, 0xff, 0xfc, 0x18, 0xf2, 0x3c, 0xf6, 0xbe, 0xaa, 0x2b, 0x4e, 0x49, 0xb6, 0x0c, 0x6f,
+	0x0e, 0xe3, 0x4a, 0x70, 0xc5, 0xd1, 0x93, 0x42, 0x90, 0x9c, 0x08, 0x5e, 0x8e, 0x6f, 0xc0, 0xe1,
+	0xb0, 0xe0, 0x05, 0xd7, 0x34, 0xec, 0x4e, 0xa6, 0x18, 0xfc, 0xea, 0xdb, 0x83, 0x49, 0x22, 0x12,
+	0x26, 0xd1, 0x85, 0xbd, 0x97, 0x50, 0xca, 0x17, 0x58, 0x40, 0x05, 0x89, 0x82, 0x1c, 0x4b, 0x10,
+	0x57, 0x24, 0x03, 0xc7, 0xf2, 0xad, 0xd1, 0xfd, 0xe8, 0xb8, 0x6d, 0xbc, 0xa3, 0x65, 0xc2, 0xe8,
+	0x49, 0x70, 0x7b, 0x2f, 0x88, 0x87, 0x1a, 0xc4, 0x9b, 0xfc, 0xdc, 0xc4, 0xe8, 0x93, 0xed, 0xd0,
+	0x4a, 0x61, 0x01, 0x52, 0x09, 0x92, 0x75, 0x1f, 0x30, 0x59, 0x60, 0xb5, 0xac, 0x40, 0x3a, 0x77,
+	0xfc, 0x9d, 0xd1, 0x83, 0xe8, 0x59, 0xdb, 0x78, 0x9e, 0x51, 0x6f, 0x6b, 0x06, 0xf1, 0x2e, 0xad,
+	0x54, 0xfc, 0x87, 0x9c, 0xc9, 0x62, 0xda, 0xe5, 0x28, 0xb5, 0x0f, 0x49, 0x9a, 0xe1, 0x94, 0xf2,
+	0xec, 0x33, 0xe4, 0x38, 0x87, 0x92, 0x33, 0x5c, 0x09, 0x98, 0x91, 0x1a, 0xa4, 0xb3, 0xa3, 0xfd,
+	0x2f, 0xda, 0xc6, 0x3b, 0x36, 0xfe, 0xed, 0xdd, 0x20, 0xde, 0x27, 0x69, 0x16, 0x19, 0x76, 0xda,
+	0xa1, 0xc9, 0x86, 0xa0, 0x77, 0x36, 0x62, 0x49, 0x8d, 0x55, 0x2d, 0x71, 0x05, 0x02, 0x2f, 0x48,
+	0x99, 0xf3, 0x85, 0xd3, 0xf7, 0xad, 0x51, 0x3f, 0x3a, 0x6a, 0x1b, 0xef, 0xc0, 0xb8, 0xff, 0xef,
+	0x04, 0xf1, 0x63, 0x96, 0xd4, 0xd3, 0x5a, 0x4e, 0x40, 0x5c, 0xe8, 0x04, 0xbd, 0xb6, 0x1f, 0x19,
+	0x66, 0xae, 0x21, 0x9d, 0xbb, 0x5a, 0xe3, 0xb4, 0x8d, 0x37, 0x34, 0x9a, 0x7f, 0x70, 0x10, 0x3f,
+	0x34, 0xef, 0xfa, 0x62, 0x12, 0x9d, 0xdb, 0xbb, 0x50, 0xce, 0xb8, 0xc8, 0x00, 0x93, 0x12, 0xe7,
+	0x40, 0xc9, 0x15, 0x08, 0xac, 0x6a, 0x67, 0xa0, 0x87, 0xe4, 0xb7, 0x8d, 0xf7, 0xd4, 0x68, 0x6e,
+	0xad, 0x05, 0x31, 0xda, 0xe4, 0x6f, 0xcb, 0x53, 0x93, 0x4e, 0x6b, 0x84, 0xed, 0x03, 0x91, 0x28,
+	0xc0, 0x94, 0x30, 0xa2, 0x30, 0xd4, 0xc0, 0x2a, 0x85, 0x19, 0xcf, 0xe7, 0x14, 0xa4, 0x73, 0x4f,
+	0xff, 0xc2, 0xe7, 0x6d, 0xe3, 0xf9, 0x46, 0xbc, 0xb5, 0x1a, 0xc4, 0x7b, 0x1d, 0xfb, 0xd0, 0xa1,
+	0x37, 0x9a, 0x9c, 0x19, 0x70, 0xd2, 0xff, 0xfa, 0xcd, 0xeb, 0x45, 0xef, 0xbf, 0xaf, 0x5c, 0xeb,
+	0x7a, 0xe5, 0x5a, 0x3f, 0x57, 0xae, 0xf5, 0x65, 0xed, 0xf6, 0xae, 0xd7, 0x6e, 0xef, 0xc7, 0xda,
+	0xed, 0x7d, 0x7c, 0x59, 0x10, 0x75, 0x39, 0x4f, 0xc7, 0x19, 0x67, 0xe1, 0x6c, 0x2e, 0x96, 0x25,
+	0x28, 0xfd, 0xbc, 0x9c, 0xa7, 0xe1, 0x46, 0x1e, 0xfe, 0xdd, 0xf5, 0x6e, 0xee, 0xe9, 0x40, 0x2f,
+	0xf0, 0xab, 0xdf, 0x03, 0x00, 0x30, 0x06, 0x78, 0x9b, 0x04, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimitExemptModules) > 0 {
+		for iNdEx := len(m.RateLimitExemptModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RateLimitExemptModules[iNdEx])
+			copy(dAtA[i:], m.RateLimitExemptModules[iNdEx])
+			i = encodeVarintTxpolicy(dAtA, i, uint64(len(m.RateLimitExemptModules[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EnforceInDeliverTx {
+		i--
+		if m.EnforceInDeliverTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTxpolicy(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTxsPerWindow != 0 {
+		i = encodeVarintTxpolicy(dAtA, i, uint64(m.MaxTxsPerWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IbcBlockedDenomPrefixes) > 0 {
+		for iNdEx := len(m.IbcBlockedDenomPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcBlockedDenomPrefixes[iNdEx])
+			copy(dAtA[i:], m.IbcBlockedDenomPrefixes[iNdEx])
+			i = encodeVarintTxpolicy(dAtA, i, uint64(len(m.IbcBlockedDenomPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LptRestrictedMsgTypes) > 0 {
+		for iNdEx := len(m.LptRestrictedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LptRestrictedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.LptRestrictedMsgTypes[iNdEx])
+			i = encodeVarintTxpolicy(dAtA, i, uint64(len(m.LptRestrictedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AllowRepeatedService {
+		i--
+		if m.AllowRepeatedService {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxpolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxpolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowRepeatedService {
+		n += 2
+	}
+	if len(m.LptRestrictedMsgTypes) > 0 {
+		for _, s := range m.LptRestrictedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovTxpolicy(uint64(l))
+		}
+	}
+	if len(m.IbcBlockedDenomPrefixes) > 0 {
+		for _, s := range m.IbcBlockedDenomPrefixes {
+			l = len(s)
+			n += 1 + l + sovTxpolicy(uint64(l))
+		}
+	}
+	if m.MaxTxsPerWindow != 0 {
+		n += 1 + sovTxpolicy(uint64(m.MaxTxsPerWindow))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTxpolicy(uint64(m.WindowBlocks))
+	}
+	if m.EnforceInDeliverTx {
+		n += 2
+	}
+	if len(m.RateLimitExemptModules) > 0 {
+		for _, s := range m.RateLimitExemptModules {
+			l = len(s)
+			n += 1 + l + sovTxpolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTxpolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxpolicy(x uint64) (n int) {
+	return sovTxpolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowRepeatedService", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowRepeatedService = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptRestrictedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptRestrictedMsgTypes = append(m.LptRestrictedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcBlockedDenomPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcBlockedDenomPrefixes = append(m.IbcBlockedDenomPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerWindow", wireType)
+			}
+			m.MaxTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceInDeliverTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceInDeliverTx = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitExemptModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitExemptModules = append(m.RateLimitExemptModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxpolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxpolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxpolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxpolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxpolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxpolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxpolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxpolicy = fmt.Errorf("proto: unexpected end of group")
+)
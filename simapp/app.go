@@ -116,12 +116,24 @@ import (
 
 	"github.com/furynet/furyhub/address"
 	"github.com/furynet/furyhub/lite"
+	"github.com/furynet/furyhub/modules/did"
+	didkeeper "github.com/furynet/furyhub/modules/did/keeper"
+	didtypes "github.com/furynet/furyhub/modules/did/types"
 	"github.com/furynet/furyhub/modules/guardian"
 	guardiankeeper "github.com/furynet/furyhub/modules/guardian/keeper"
 	guardiantypes "github.com/furynet/furyhub/modules/guardian/types"
+	"github.com/furynet/furyhub/modules/htlcasset"
+	htlcassetkeeper "github.com/furynet/furyhub/modules/htlcasset/keeper"
+	htlcassettypes "github.com/furynet/furyhub/modules/htlcasset/types"
 	"github.com/furynet/furyhub/modules/mint"
 	mintkeeper "github.com/furynet/furyhub/modules/mint/keeper"
 	minttypes "github.com/furynet/furyhub/modules/mint/types"
+	"github.com/furynet/furyhub/modules/ratelimit"
+	ratelimitkeeper "github.com/furynet/furyhub/modules/ratelimit/keeper"
+	ratelimittypes "github.com/furynet/furyhub/modules/ratelimit/types"
+	"github.com/furynet/furyhub/modules/txpolicy"
+	txpolicykeeper "github.com/furynet/furyhub/modules/txpolicy/keeper"
+	txpolicytypes "github.com/furynet/furyhub/modules/txpolicy/types"
 
 	"github.com/irisnet/irismod/modules/farm"
 	farmkeeper "github.com/irisnet/irismod/modules/farm/keeper"
@@ -188,10 +200,14 @@ var (
 		feegrantmodule.AppModuleBasic{},
 
 		guardian.AppModuleBasic{},
+		txpolicy.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
 		htlc.AppModuleBasic{},
+		htlcasset.AppModuleBasic{},
+		did.AppModuleBasic{},
 		coinswap.AppModuleBasic{},
 		service.AppModuleBasic{},
 		oracle.AppModuleBasic{},
@@ -276,11 +292,15 @@ type SimApp struct {
 	scopedTIBCMockKeeper capabilitykeeper.ScopedKeeper
 
 	GuardianKeeper    guardiankeeper.Keeper
+	TxPolicyKeeper    txpolicykeeper.Keeper
+	RateLimitKeeper   ratelimitkeeper.Keeper
 	TokenKeeper       tokenkeeper.Keeper
 	RecordKeeper      recordkeeper.Keeper
 	NFTKeeper         nftkeeper.Keeper
 	MTKeeper          mtkeeper.Keeper
 	HTLCKeeper        htlckeeper.Keeper
+	HTLCAssetKeeper   htlcassetkeeper.Keeper
+	DIDKeeper         didkeeper.Keeper
 	CoinswapKeeper    coinswapkeeper.Keeper
 	ServiceKeeper     servicekeeper.Keeper
 	OracleKeeper      oraclekeeper.Keeper
@@ -375,6 +395,7 @@ func NewSimApp(
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
 		farmtypes.StoreKey, feegrant.StoreKey, tibchost.StoreKey, tibcnfttypes.StoreKey, tibcmttypes.StoreKey, mttypes.StoreKey,
+		txpolicytypes.StoreKey, ratelimittypes.StoreKey, didtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -422,7 +443,7 @@ func NewSimApp(
 		app.AccountKeeper,
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.AccountKeeper,
@@ -430,6 +451,17 @@ func NewSimApp(
 		app.BlockedModuleAccountAddrs(),
 	)
 
+	app.GuardianKeeper = guardiankeeper.NewKeeper(
+		appCodec,
+		keys[guardiantypes.StoreKey],
+		app.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// blocked addresses of the guardian module can't receive funds, the bank
+	// module itself keeps the base keeper as it registers its migrations on it
+	app.BankKeeper = guardiankeeper.NewSendRestrictedBankKeeper(bankKeeper, app.GuardianKeeper)
+
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -532,11 +564,21 @@ func NewSimApp(
 		app.TIBCKeeper.ClientKeeper,
 	)
 
+	// the rate limit keeper sits between the transfer module and core IBC
+	// to account the outgoing transfers
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.GuardianKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.RateLimitKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -545,11 +587,12 @@ func NewSimApp(
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	transferStack := ratelimit.NewIBCMiddleware(transferIBCModule, app.RateLimitKeeper)
 
 	// routerModule := router.NewAppModule(app.RouterKeeper, transferIBCModule)
 	// create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	nfttransferModule := tibcnfttransfer.NewAppModule(app.NFTTransferKeeper)
@@ -570,9 +613,9 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.GuardianKeeper = guardiankeeper.NewKeeper(
-		appCodec,
-		keys[guardiantypes.StoreKey],
+	app.TxPolicyKeeper = txpolicykeeper.NewKeeper(
+		keys[txpolicytypes.StoreKey],
+		app.GetSubspace(txpolicytypes.ModuleName),
 	)
 
 	app.TokenKeeper = tokenkeeper.NewKeeper(
@@ -589,6 +632,14 @@ func NewSimApp(
 		keys[recordtypes.StoreKey],
 	)
 
+	app.DIDKeeper = didkeeper.NewKeeper(
+		appCodec,
+		keys[didtypes.StoreKey],
+		app.AccountKeeper,
+		app.StakingKeeper,
+		app.RecordKeeper,
+	)
+
 	app.HTLCKeeper = htlckeeper.NewKeeper(
 		appCodec, keys[htlctypes.StoreKey],
 		app.GetSubspace(htlctypes.ModuleName),
@@ -597,6 +648,12 @@ func NewSimApp(
 		app.ModuleAccountAddrs(),
 	)
 
+	app.HTLCAssetKeeper = htlcassetkeeper.NewKeeper(
+		app.HTLCKeeper,
+		app.GuardianKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.CoinswapKeeper = coinswapkeeper.NewKeeper(
 		appCodec,
 		keys[coinswaptypes.StoreKey],
@@ -686,7 +743,7 @@ func NewSimApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		bank.NewAppModule(appCodec, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
@@ -703,11 +760,15 @@ func NewSimApp(
 		nfttransferModule,
 		mttransferModule,
 		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		txpolicy.NewAppModule(appCodec, app.TxPolicyKeeper),
+		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
-		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
+		did.NewRecordAppModule(record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper), app.RecordKeeper, app.DIDKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
 		mt.NewAppModule(appCodec, app.MTKeeper, app.AccountKeeper, app.BankKeeper),
 		htlc.NewAppModule(appCodec, app.HTLCKeeper, app.AccountKeeper, app.BankKeeper),
+		htlcasset.NewAppModule(appCodec, app.HTLCAssetKeeper),
+		did.NewAppModule(appCodec, app.DIDKeeper),
 		coinswap.NewAppModule(appCodec, app.CoinswapKeeper, app.AccountKeeper, app.BankKeeper),
 		service.NewAppModule(appCodec, app.ServiceKeeper, app.AccountKeeper, app.BankKeeper),
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
@@ -755,6 +816,10 @@ func NewSimApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txpolicytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		htlcassettypes.ModuleName,
+		didtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		//sdk module
@@ -792,6 +857,10 @@ func NewSimApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txpolicytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		htlcassettypes.ModuleName,
+		didtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -835,6 +904,10 @@ func NewSimApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txpolicytypes.ModuleName,
+		ratelimittypes.ModuleName,
+		htlcassettypes.ModuleName,
+		didtypes.ModuleName,
 	)
 
 	cfg := module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -848,7 +921,7 @@ func NewSimApp(
 	// transactions
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		bank.NewAppModule(appCodec, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper),
@@ -1041,6 +1114,10 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the DID resolution route, serving the DID documents in their
+	// JSON-LD representation.
+	did.AppModuleBasic{}.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		lite.RegisterSwaggerAPI(clientCtx, apiSvr.Router)
@@ -1079,6 +1156,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
 	paramsKeeper.Subspace(minttypes.ModuleName)
+	paramsKeeper.Subspace(txpolicytypes.ModuleName)
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())